@@ -203,6 +203,8 @@ type Config struct {
 	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
 	Insecure          bool
 
+	DisableInvitationEmailNotifications bool
+
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
 	SkipRegionValidation    bool
@@ -264,6 +266,7 @@ type AWSClient struct {
 	DefaultTagsConfig                   *keyvaluetags.DefaultConfig
 	detectiveconn                       *detective.Detective
 	devicefarmconn                      *devicefarm.DeviceFarm
+	DisableInvitationEmailNotifications bool
 	dlmconn                             *dlm.DLM
 	dmsconn                             *databasemigrationservice.DatabaseMigrationService
 	dnsSuffix                           string
@@ -515,6 +518,7 @@ func (c *Config) Client() (interface{}, error) {
 		DefaultTagsConfig:                   c.DefaultTagsConfig,
 		detectiveconn:                       detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["detective"])})),
 		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
+		DisableInvitationEmailNotifications: c.DisableInvitationEmailNotifications,
 		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
 		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
 		dnsSuffix:                           dnsSuffix,
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func dataSourceAwsDetectiveAdministrator() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsDetectiveAdministratorRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"administrators": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"graph_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invited_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invited_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsDetectiveAdministratorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	// A member account cannot call GetMembers on the graph of its administrator account,
+	// its administrator accounts are the senders of its invitations.
+	invitations, err := finder.Invitations(ctx, conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Detective invitations: %w", err))
+	}
+
+	var tfList []interface{}
+
+	for _, invitation := range invitations {
+		tfList = append(tfList, flattenDetectiveAdministrator(invitation))
+	}
+
+	if err := d.Set("administrators", tfList); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting administrators: %w", err))
+	}
+
+	// The top-level attributes are only set when there is no ambiguity on the administrator account.
+	if len(tfList) == 1 {
+		tfMap := tfList[0].(map[string]interface{})
+
+		d.Set("account_id", tfMap["account_id"])
+		d.Set("graph_arn", tfMap["graph_arn"])
+		d.Set("invited_time", tfMap["invited_time"])
+		d.Set("status", tfMap["status"])
+	} else {
+		d.Set("account_id", nil)
+		d.Set("graph_arn", nil)
+		d.Set("invited_time", nil)
+		d.Set("status", nil)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	return nil
+}
+
+func flattenDetectiveAdministrator(apiObject *detective.MemberDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"account_id": aws.StringValue(apiObject.MasterId),
+		"graph_arn":  aws.StringValue(apiObject.GraphArn),
+		"status":     aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.InvitedTime; v != nil {
+		tfMap["invited_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+func TestFlattenDetectiveAdministrator(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *detective.MemberDetail
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name: "invited",
+			Input: &detective.MemberDetail{
+				GraphArn:    aws.String("arn:aws:detective:us-east-1:123456789012:graph:a"), //lintignore:AWSAT003,AWSAT005
+				InvitedTime: aws.Time(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)),
+				MasterId:    aws.String("123456789012"),
+				Status:      aws.String(detective.MemberStatusInvited),
+			},
+			Expected: map[string]interface{}{
+				"account_id":   "123456789012",
+				"graph_arn":    "arn:aws:detective:us-east-1:123456789012:graph:a", //lintignore:AWSAT003,AWSAT005
+				"invited_time": "2021-06-01T12:00:00Z",
+				"status":       detective.MemberStatusInvited,
+			},
+		},
+		{
+			Name: "no invited time",
+			Input: &detective.MemberDetail{
+				GraphArn: aws.String("arn:aws:detective:us-east-1:123456789012:graph:a"), //lintignore:AWSAT003,AWSAT005
+				MasterId: aws.String("123456789012"),
+				Status:   aws.String(detective.MemberStatusEnabled),
+			},
+			Expected: map[string]interface{}{
+				"account_id": "123456789012",
+				"graph_arn":  "arn:aws:detective:us-east-1:123456789012:graph:a", //lintignore:AWSAT003,AWSAT005
+				"status":     detective.MemberStatusEnabled,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenDetectiveAdministrator(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccAwsDetectiveAdministratorDataSource_basic(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_detective_administrator.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveAdministratorDataSourceConfigBasic(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "administrators.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "administrators.0.account_id", "data.aws_caller_identity.admin", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "administrators.0.graph_arn", "aws_detective_graph.admin", "id"),
+					testAccCheckResourceAttrRfc3339(dataSourceName, "administrators.0.invited_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "administrators.0.status", detective.MemberStatusInvited),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", "data.aws_caller_identity.admin", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "graph_arn", "aws_detective_graph.admin", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", detective.MemberStatusInvited),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveAdministratorDataSourceConfigBasic(email string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "admin" {}
+
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "admin" {}
+
+resource "aws_detective_invitation_request" "member" {
+  graph_arn                  = aws_detective_graph.admin.id
+  account                    = data.aws_caller_identity.member.account_id
+  email                      = %[1]q
+  disable_email_notification = true
+}
+
+data "aws_detective_administrator" "test" {
+  provider = "awsalternate"
+
+  depends_on = [aws_detective_invitation_request.member]
+}
+`, email)
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func dataSourceAwsDetectiveGraph() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsDetectiveGraphRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsDetectiveGraphRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	graphs, err := finder.Graphs(ctx, conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Detective Graphs: %w", err))
+	}
+
+	if v, ok := d.GetOk("arn"); ok {
+		var filtered []*detective.Graph
+
+		for _, graph := range graphs {
+			if aws.StringValue(graph.Arn) == v.(string) {
+				filtered = append(filtered, graph)
+			}
+		}
+
+		graphs = filtered
+	}
+
+	tags, err := detectiveGraphsTags(ctx, conn, graphs)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	i, err := detectiveGraphMatch(graphs, tags, keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	graph := graphs[i]
+
+	d.SetId(aws.StringValue(graph.Arn))
+	d.Set("arn", graph.Arn)
+	d.Set("created_time", aws.TimeValue(graph.CreatedTime).Format(time.RFC3339))
+
+	if err := d.Set("tags", keyvaluetags.New(tags[i]).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	return nil
+}
+
+// detectiveGraphMatch returns the index of the only graph whose tags contain all the filter tags.
+// An error listing the matching graph ARNs is returned when more than one graph matches.
+func detectiveGraphMatch(graphs []*detective.Graph, tags []map[string]*string, filter keyvaluetags.KeyValueTags) (int, error) {
+	var matches []int
+
+	for i := range graphs {
+		if keyvaluetags.New(tags[i]).ContainsAll(filter) {
+			matches = append(matches, i)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("no Detective Graph matched, change the search criteria and try again")
+	case 1:
+		return matches[0], nil
+	}
+
+	graphARNs := make([]string, len(matches))
+
+	for i, match := range matches {
+		graphARNs[i] = aws.StringValue(graphs[match].Arn)
+	}
+
+	sort.Strings(graphARNs)
+
+	return 0, fmt.Errorf("multiple Detective Graphs matched (%s), change the search criteria and try again", strings.Join(graphARNs, ", "))
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func TestDetectiveGraphMatch(t *testing.T) {
+	graphs := []*detective.Graph{
+		{Arn: aws.String("arn:aws:detective:us-east-1:123456789012:graph:b")}, //lintignore:AWSAT003,AWSAT005
+		{Arn: aws.String("arn:aws:detective:us-east-1:123456789012:graph:a")}, //lintignore:AWSAT003,AWSAT005
+	}
+	tags := []map[string]*string{
+		aws.StringMap(map[string]string{"Environment": "production", "Team": "security"}),
+		aws.StringMap(map[string]string{"Environment": "staging", "Team": "security"}),
+	}
+
+	testCases := []struct {
+		Name          string
+		Filter        map[string]string
+		Expected      int
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:     "single match",
+			Filter:   map[string]string{"Environment": "staging"},
+			Expected: 1,
+		},
+		{
+			Name:          "no match",
+			Filter:        map[string]string{"Environment": "development"},
+			ExpectedError: regexp.MustCompile(`no Detective Graph matched`),
+		},
+		{
+			Name:          "multiple matches",
+			Filter:        map[string]string{"Team": "security"},
+			ExpectedError: regexp.MustCompile(`multiple Detective Graphs matched \(arn:aws:detective:us-east-1:123456789012:graph:a, arn:aws:detective:us-east-1:123456789012:graph:b\)`), //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:          "no filter",
+			ExpectedError: regexp.MustCompile(`multiple Detective Graphs matched`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := detectiveGraphMatch(graphs, tags, keyvaluetags.New(testCase.Filter))
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatalf("expected error, got no error")
+				}
+
+				if !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("got error %q, expected to match %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccAwsDetectiveGraphDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_detective_graph.test"
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveGraphDataSourceConfigTags("value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "id"),
+					testAccCheckResourceAttrRfc3339(dataSourceName, "created_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Key", "value"),
+				),
+			},
+			{
+				Config:      testAccAwsDetectiveGraphDataSourceConfigTags("other"),
+				ExpectError: regexp.MustCompile(`no Detective Graph matched`),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveGraphDataSourceConfigTags(value string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  graph_tags = {
+    Key = "value"
+  }
+}
+
+data "aws_detective_graph" "test" {
+  tags = {
+    Key = %[1]q
+  }
+
+  depends_on = [aws_detective_graph.test]
+}
+`, value)
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+// detectiveGraphsTagsConcurrency is the maximum number of concurrent ListTagsForResource calls.
+const detectiveGraphsTagsConcurrency = 5
+
+func dataSourceAwsDetectiveGraphs() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsDetectiveGraphsRead,
+		Schema: map[string]*schema.Schema{
+			"include_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"graph_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"graphs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsDetectiveGraphsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphs, err := finder.Graphs(ctx, conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Detective Graphs: %w", err))
+	}
+
+	tags := make([]map[string]*string, len(graphs))
+
+	if d.Get("include_tags").(bool) {
+		if tags, err = detectiveGraphsTags(ctx, conn, graphs); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var graphARNs []string
+	var tfList []interface{}
+
+	for i, graph := range graphs {
+		graphARNs = append(graphARNs, aws.StringValue(graph.Arn))
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":          aws.StringValue(graph.Arn),
+			"created_time": aws.TimeValue(graph.CreatedTime).Format(time.RFC3339),
+			"tags":         aws.StringValueMap(tags[i]),
+		})
+	}
+
+	if err := d.Set("graph_arns", graphARNs); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting graph_arns: %w", err))
+	}
+
+	if err := d.Set("graphs", tfList); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting graphs: %w", err))
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	return nil
+}
+
+// detectiveGraphsTags returns the tags of each graph, in the same order as the graphs.
+// At most detectiveGraphsTagsConcurrency ListTagsForResource calls are made concurrently.
+func detectiveGraphsTags(ctx context.Context, conn *detective.Detective, graphs []*detective.Graph) ([]map[string]*string, error) {
+	tags := make([]map[string]*string, len(graphs))
+	errs := make([]error, len(graphs))
+	semaphore := make(chan struct{}, detectiveGraphsTagsConcurrency)
+
+	var wg sync.WaitGroup
+
+	for i, graph := range graphs {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int, graphARN string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			input := &detective.ListTagsForResourceInput{
+				ResourceArn: aws.String(graphARN),
+			}
+
+			output, err := conn.ListTagsForResourceWithContext(ctx, input)
+
+			if err != nil {
+				errs[i] = fmt.Errorf("error listing tags for Detective Graph (%s): %w", graphARN, err)
+				return
+			}
+
+			if output != nil {
+				tags[i] = output.Tags
+			}
+		}(i, aws.StringValue(graph.Arn))
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccAwsDetectiveGraphsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_detective_graphs.test"
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveGraphsDataSourceConfigIncludeTags(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "graph_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "graph_arns.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "graphs.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "graphs.0.arn", resourceName, "id"),
+					testAccCheckResourceAttrRfc3339(dataSourceName, "graphs.0.created_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "graphs.0.tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAwsDetectiveGraphsDataSourceConfigIncludeTags(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "graphs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "graphs.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "graphs.0.tags.Key", "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveGraphsDataSourceConfigIncludeTags(includeTags bool) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  graph_tags = {
+    Key = "value"
+  }
+}
+
+data "aws_detective_graphs" "test" {
+  include_tags = %[1]t
+
+  depends_on = [aws_detective_graph.test]
+}
+`, includeTags)
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func dataSourceAwsDetectiveInvitations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsDetectiveInvitationsRead,
+		Schema: map[string]*schema.Schema{
+			"invitation_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"graph_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"invitations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"graph_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invited_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(detective.MemberStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceAwsDetectiveInvitationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	invitations, err := finder.Invitations(ctx, conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Detective invitations: %w", err))
+	}
+
+	// ListInvitations has no status filter, so the invitations are filtered once all the pages are listed.
+	invitations = detectiveInvitationsFilterByStatus(invitations, d.Get("status").(string))
+
+	var graphARNs []string
+	var tfList []interface{}
+
+	for _, invitation := range invitations {
+		graphARNs = append(graphARNs, aws.StringValue(invitation.GraphArn))
+
+		tfMap := map[string]interface{}{
+			"graph_arn": aws.StringValue(invitation.GraphArn),
+			"status":    aws.StringValue(invitation.Status),
+		}
+
+		if invitation.InvitedTime != nil {
+			tfMap["invited_time"] = aws.TimeValue(invitation.InvitedTime).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.Set("invitation_count", len(invitations))
+
+	if err := d.Set("graph_arns", graphARNs); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting graph_arns: %w", err))
+	}
+
+	if err := d.Set("invitations", tfList); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting invitations: %w", err))
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	return nil
+}
+
+// detectiveInvitationsFilterByStatus returns the invitations with the specified status, or all the invitations when status is empty.
+func detectiveInvitationsFilterByStatus(invitations []*detective.MemberDetail, status string) []*detective.MemberDetail {
+	if status == "" {
+		return invitations
+	}
+
+	var filtered []*detective.MemberDetail
+
+	for _, invitation := range invitations {
+		if aws.StringValue(invitation.Status) == status {
+			filtered = append(filtered, invitation)
+		}
+	}
+
+	return filtered
+}
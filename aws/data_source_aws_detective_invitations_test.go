@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+func TestDetectiveInvitationsFilterByStatus(t *testing.T) {
+	invited := &detective.MemberDetail{
+		GraphArn: aws.String("arn:aws:detective:us-east-1:123456789012:graph:a"), //lintignore:AWSAT003,AWSAT005
+		Status:   aws.String(detective.MemberStatusInvited),
+	}
+	enabled := &detective.MemberDetail{
+		GraphArn: aws.String("arn:aws:detective:us-east-1:210987654321:graph:b"), //lintignore:AWSAT003,AWSAT005
+		Status:   aws.String(detective.MemberStatusEnabled),
+	}
+	invitations := []*detective.MemberDetail{invited, enabled}
+
+	testCases := []struct {
+		Name     string
+		Status   string
+		Expected []*detective.MemberDetail
+	}{
+		{
+			Name:     "no status",
+			Expected: invitations,
+		},
+		{
+			Name:     "invited",
+			Status:   detective.MemberStatusInvited,
+			Expected: []*detective.MemberDetail{invited},
+		},
+		{
+			Name:   "no match",
+			Status: detective.MemberStatusAcceptedButDisabled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := detectiveInvitationsFilterByStatus(invitations, testCase.Status)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccAwsDetectiveInvitationsDataSource_basic(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_detective_invitations.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveInvitationsDataSourceConfigStatus(email, detective.MemberStatusInvited),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "status", detective.MemberStatusInvited),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "graph_arns.*", "aws_detective_graph.admin", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "invitations.*", map[string]string{
+						"status": detective.MemberStatusInvited,
+					}),
+				),
+			},
+			{
+				Config: testAccAwsDetectiveInvitationsDataSourceConfigStatus(email, detective.MemberStatusAcceptedButDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "invitation_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "graph_arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveInvitationsDataSourceConfigStatus(email, status string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "admin" {}
+
+resource "aws_detective_invitation_request" "member" {
+  graph_arn                  = aws_detective_graph.admin.id
+  account                    = data.aws_caller_identity.member.account_id
+  email                      = %[1]q
+  disable_email_notification = true
+}
+
+data "aws_detective_invitations" "test" {
+  provider = "awsalternate"
+
+  status = %[2]q
+
+  depends_on = [aws_detective_invitation_request.member]
+}
+`, email, status)
+}
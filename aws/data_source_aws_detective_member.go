@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func dataSourceAwsDetectiveMember() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsDetectiveMemberRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exists": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"percent_of_graph_utilization": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"percent_of_graph_utilization_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_usage_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"volume_usage_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsDetectiveMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN := d.Get("graph_arn").(string)
+	accountID := d.Get("account_id").(string)
+
+	member, err := finder.MemberByGraphARNAndAccountID(ctx, conn, graphARN, accountID)
+
+	if err != nil && !tfresource.NotFound(err) {
+		return diag.FromErr(fmt.Errorf("error reading Detective member (%s) of graph (%s): %w", accountID, graphARN, err))
+	}
+
+	d.SetId(graphARN + IdSeparator + accountID)
+
+	// A missing member is not an error so that configurations can decide whether to invite the account.
+	if member == nil {
+		d.Set("disabled_reason", nil)
+		d.Set("email", nil)
+		d.Set("exists", false)
+		d.Set("percent_of_graph_utilization", nil)
+		d.Set("percent_of_graph_utilization_updated_time", nil)
+		d.Set("status", nil)
+		d.Set("volume_usage_in_bytes", nil)
+		d.Set("volume_usage_updated_time", nil)
+
+		return nil
+	}
+
+	d.Set("disabled_reason", member.DisabledReason)
+	d.Set("email", member.EmailAddress)
+	d.Set("exists", true)
+	d.Set("status", member.Status)
+
+	// Detective does not expose the volume thresholds that disable members, only the usage they are compared against.
+	d.Set("percent_of_graph_utilization", member.PercentOfGraphUtilization)
+	d.Set("percent_of_graph_utilization_updated_time", detectiveMemberFormatTime(member.PercentOfGraphUtilizationUpdatedTime))
+	d.Set("volume_usage_in_bytes", member.VolumeUsageInBytes)
+	d.Set("volume_usage_updated_time", detectiveMemberFormatTime(member.VolumeUsageUpdatedTime))
+
+	return nil
+}
+
+// detectiveMemberFormatTime formats an optional member timestamp, returning an empty string when it is not set.
+func detectiveMemberFormatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).UTC().Format(time.RFC3339)
+}
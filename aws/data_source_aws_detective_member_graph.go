@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func dataSourceAwsDetectiveMemberGraph() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsDetectiveMemberGraphRead,
+		Schema: map[string]*schema.Schema{
+			"administrator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exists": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsDetectiveMemberGraphRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	// A member account cannot call GetMembers on the graph of its administrator account,
+	// the graphs it belongs to are the ones of its accepted invitations.
+	invitations, err := finder.Invitations(ctx, conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Detective invitations: %w", err))
+	}
+
+	membership, err := detectiveMemberGraphMembership(invitations)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	// Not belonging to a graph is not an error so that configurations can be shared with accounts that are not members yet.
+	if membership == nil {
+		d.Set("administrator_account_id", nil)
+		d.Set("exists", false)
+		d.Set("graph_arn", nil)
+		d.Set("status", nil)
+
+		return nil
+	}
+
+	d.Set("administrator_account_id", membership.MasterId)
+	d.Set("exists", true)
+	d.Set("graph_arn", membership.GraphArn)
+	d.Set("status", membership.Status)
+
+	return nil
+}
+
+// detectiveMemberGraphMembership returns the accepted invitation of the behavior graph the account belongs to, if any.
+// An error is returned when the account belongs to more than one behavior graph.
+func detectiveMemberGraphMembership(invitations []*detective.MemberDetail) (*detective.MemberDetail, error) {
+	var memberships []*detective.MemberDetail
+
+	for _, invitation := range invitations {
+		switch aws.StringValue(invitation.Status) {
+		case detective.MemberStatusEnabled, detective.MemberStatusAcceptedButDisabled:
+			memberships = append(memberships, invitation)
+		}
+	}
+
+	switch len(memberships) {
+	case 0:
+		return nil, nil
+	case 1:
+		return memberships[0], nil
+	}
+
+	graphARNs := make([]string, len(memberships))
+
+	for i, membership := range memberships {
+		graphARNs[i] = aws.StringValue(membership.GraphArn)
+	}
+
+	sort.Strings(graphARNs)
+
+	return nil, fmt.Errorf("the account is a member of multiple Detective behavior graphs (%s), use the aws_detective_invitations data source instead", strings.Join(graphARNs, ", "))
+}
@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+func TestDetectiveMemberGraphMembership(t *testing.T) {
+	invited := &detective.MemberDetail{
+		GraphArn: aws.String("arn:aws:detective:us-east-1:123456789012:graph:a"), //lintignore:AWSAT003,AWSAT005
+		Status:   aws.String(detective.MemberStatusInvited),
+	}
+	enabled := &detective.MemberDetail{
+		GraphArn: aws.String("arn:aws:detective:us-east-1:210987654321:graph:b"), //lintignore:AWSAT003,AWSAT005
+		Status:   aws.String(detective.MemberStatusEnabled),
+	}
+	disabled := &detective.MemberDetail{
+		GraphArn: aws.String("arn:aws:detective:us-east-1:111111111111:graph:c"), //lintignore:AWSAT003,AWSAT005
+		Status:   aws.String(detective.MemberStatusAcceptedButDisabled),
+	}
+
+	testCases := []struct {
+		Name          string
+		Invitations   []*detective.MemberDetail
+		Expected      *detective.MemberDetail
+		ExpectedError bool
+	}{
+		{
+			Name: "no invitations",
+		},
+		{
+			Name:        "not accepted",
+			Invitations: []*detective.MemberDetail{invited},
+		},
+		{
+			Name:        "enabled",
+			Invitations: []*detective.MemberDetail{invited, enabled},
+			Expected:    enabled,
+		},
+		{
+			Name:        "accepted but disabled",
+			Invitations: []*detective.MemberDetail{disabled},
+			Expected:    disabled,
+		},
+		{
+			Name:          "multiple graphs",
+			Invitations:   []*detective.MemberDetail{enabled, disabled},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := detectiveMemberGraphMembership(testCase.Invitations)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccAwsDetectiveMemberGraphDataSource_basic(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_detective_member_graph.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveMemberGraphDataSourceConfigInvited(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "graph_arn", ""),
+				),
+			},
+			{
+				Config: testAccAwsDetectiveMemberGraphDataSourceConfigAccepted(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "graph_arn", "aws_detective_graph.admin", "id"),
+					testAccCheckResourceAttrAccountID(dataSourceName, "administrator_account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveMemberGraphDataSourceConfigBase(email string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "admin" {}
+
+resource "aws_detective_invitation_request" "member" {
+  graph_arn                  = aws_detective_graph.admin.id
+  account                    = data.aws_caller_identity.member.account_id
+  email                      = %[1]q
+  disable_email_notification = true
+}
+`, email)
+}
+
+func testAccAwsDetectiveMemberGraphDataSourceConfigInvited(email string) string {
+	return composeConfig(testAccAwsDetectiveMemberGraphDataSourceConfigBase(email), `
+data "aws_detective_member_graph" "test" {
+  provider = "awsalternate"
+
+  depends_on = [aws_detective_invitation_request.member]
+}
+`)
+}
+
+func testAccAwsDetectiveMemberGraphDataSourceConfigAccepted(email string) string {
+	return composeConfig(testAccAwsDetectiveMemberGraphDataSourceConfigBase(email), `
+resource "aws_detective_invitation_accept" "member" {
+  provider  = "awsalternate"
+  graph_arn = aws_detective_graph.admin.id
+
+  depends_on = [aws_detective_invitation_request.member]
+}
+
+data "aws_detective_member_graph" "test" {
+  provider = "awsalternate"
+
+  depends_on = [aws_detective_invitation_accept.member]
+}
+`)
+}
@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+func testAccAwsDetectiveMemberDataSource_basic(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_detective_member.test"
+	resourceName := "aws_detective_invitation_request.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveMemberDataSourceConfigMissing(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", ""),
+				),
+			},
+			{
+				Config: testAccAwsDetectiveMemberDataSourceConfigExisting(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttr(dataSourceName, "email", email),
+					resource.TestCheckResourceAttrSet(dataSourceName, "volume_usage_in_bytes"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "percent_of_graph_utilization"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveMemberDataSourceConfigBase() string {
+	return testAccAlternateAccountProviderConfig() + `
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "admin" {}
+`
+}
+
+func testAccAwsDetectiveMemberDataSourceConfigMissing() string {
+	return composeConfig(testAccAwsDetectiveMemberDataSourceConfigBase(), `
+data "aws_detective_member" "test" {
+  graph_arn  = aws_detective_graph.admin.id
+  account_id = data.aws_caller_identity.member.account_id
+}
+`)
+}
+
+func testAccAwsDetectiveMemberDataSourceConfigExisting(email string) string {
+	return composeConfig(testAccAwsDetectiveMemberDataSourceConfigBase(), fmt.Sprintf(`
+resource "aws_detective_invitation_request" "member" {
+  graph_arn                  = aws_detective_graph.admin.id
+  account                    = data.aws_caller_identity.member.account_id
+  email                      = %[1]q
+  disable_email_notification = true
+}
+
+data "aws_detective_member" "test" {
+  graph_arn  = aws_detective_graph.admin.id
+  account_id = data.aws_caller_identity.member.account_id
+
+  depends_on = [aws_detective_invitation_request.member]
+}
+`, email))
+}
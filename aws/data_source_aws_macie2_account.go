@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func dataSourceAwsMacie2Account() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsMacie2AccountRead,
+		Schema: map[string]*schema.Schema{
+			"administrator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finding_publishing_frequency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_delegated_administrator": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsMacie2AccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+	accountID := meta.(*AWSClient).accountid
+
+	session, err := conn.GetMacieSessionWithContext(ctx, &macie2.GetMacieSessionInput{})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie Account (%s): %w", accountID, err))
+	}
+
+	administrator, err := conn.GetAdministratorAccountWithContext(ctx, &macie2.GetAdministratorAccountInput{})
+
+	if err != nil && !tfmacie2.IsNotFoundError(err) {
+		return diag.FromErr(fmt.Errorf("error reading Macie Account (%s) administrator: %w", accountID, err))
+	}
+
+	isDelegatedAdministrator, err := macie2AccountIsDelegatedAdministrator(ctx, conn, accountID)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie Account (%s) organization administrator status: %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+
+	if administrator != nil && administrator.Administrator != nil {
+		d.Set("administrator_account_id", administrator.Administrator.AccountId)
+	} else {
+		d.Set("administrator_account_id", nil)
+	}
+
+	d.Set("created_at", aws.TimeValue(session.CreatedAt).Format(time.RFC3339))
+	d.Set("finding_publishing_frequency", session.FindingPublishingFrequency)
+	d.Set("is_delegated_administrator", isDelegatedAdministrator)
+	d.Set("service_role", session.ServiceRole)
+	d.Set("status", session.Status)
+	d.Set("updated_at", aws.TimeValue(session.UpdatedAt).Format(time.RFC3339))
+
+	return nil
+}
+
+// macie2AccountIsDelegatedAdministrator returns whether the account is the delegated Macie administrator of its organization.
+// Only the organization management account can list the delegated administrators, other accounts fall back to
+// describing the organization configuration, which only succeeds for the delegated administrator.
+func macie2AccountIsDelegatedAdministrator(ctx context.Context, conn *macie2.Macie2, accountID string) (bool, error) {
+	adminAccounts, err := finder.OrganizationAdminAccounts(conn)
+
+	if err == nil {
+		for _, adminAccount := range adminAccounts {
+			if aws.StringValue(adminAccount.AccountId) == accountID && aws.StringValue(adminAccount.Status) == macie2.AdminStatusEnabled {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	if !tfawserr.ErrCodeEquals(err, macie2.ErrCodeAccessDeniedException) && !tfawserr.ErrCodeEquals(err, macie2.ErrCodeValidationException) {
+		return false, err
+	}
+
+	_, err = conn.DescribeOrganizationConfigurationWithContext(ctx, &macie2.DescribeOrganizationConfigurationInput{})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeAccessDeniedException) || tfawserr.ErrCodeEquals(err, macie2.ErrCodeValidationException) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccAwsMacie2AccountDataSource_basic(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+	dataSourceName := "data.aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsMacie2AccountDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "finding_publishing_frequency", resourceName, "finding_publishing_frequency"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_role", resourceName, "service_role"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttr(dataSourceName, "administrator_account_id", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "is_delegated_administrator", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2AccountDataSourceConfigBasic() string {
+	return `
+resource "aws_macie2_account" "test" {}
+
+data "aws_macie2_account" "test" {
+  depends_on = [aws_macie2_account.test]
+}
+`
+}
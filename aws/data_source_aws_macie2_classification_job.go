@@ -0,0 +1,315 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsMacie2ClassificationJob() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsMacie2ClassificationJobRead,
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_run_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_job_definition": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_definitions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"buckets": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"scoping": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"excludes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"and": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"simple_scope_term": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"comparator": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																		"values": {
+																			Type:     schema.TypeList,
+																			Computed: true,
+																			Elem:     &schema.Schema{Type: schema.TypeString},
+																		},
+																		"key": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																	},
+																},
+															},
+															"tag_scope_term": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"comparator": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																		"tag_values": {
+																			Type:     schema.TypeList,
+																			Computed: true,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"value": {
+																						Type:     schema.TypeString,
+																						Computed: true,
+																					},
+																					"key": {
+																						Type:     schema.TypeString,
+																						Computed: true,
+																					},
+																				},
+																			},
+																		},
+																		"key": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																		"target": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"includes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"and": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"simple_scope_term": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"comparator": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																		"values": {
+																			Type:     schema.TypeList,
+																			Computed: true,
+																			Elem:     &schema.Schema{Type: schema.TypeString},
+																		},
+																		"key": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																	},
+																},
+															},
+															"tag_scope_term": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"comparator": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																		"tag_values": {
+																			Type:     schema.TypeList,
+																			Computed: true,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"value": {
+																						Type:     schema.TypeString,
+																						Computed: true,
+																					},
+																					"key": {
+																						Type:     schema.TypeString,
+																						Computed: true,
+																					},
+																				},
+																			},
+																		},
+																		"key": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																		"target": {
+																			Type:     schema.TypeString,
+																			Computed: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"sampling_percentage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"approximate_number_of_objects_to_process": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"number_of_runs": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsMacie2ClassificationJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	jobID := d.Get("job_id").(string)
+
+	resp, err := conn.DescribeClassificationJobWithContext(ctx, &macie2.DescribeClassificationJobInput{
+		JobId: aws.String(jobID),
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie ClassificationJob (%s): %w", jobID, err))
+	}
+
+	d.SetId(aws.StringValue(resp.JobId))
+
+	// Unlike the resource, the data source reports the actual job status.
+	d.Set("created_at", aws.TimeValue(resp.CreatedAt).Format(time.RFC3339))
+	d.Set("description", resp.Description)
+	d.Set("job_arn", resp.JobArn)
+	d.Set("job_id", resp.JobId)
+	d.Set("job_status", resp.JobStatus)
+	d.Set("job_type", resp.JobType)
+
+	if resp.LastRunTime != nil {
+		d.Set("last_run_time", aws.TimeValue(resp.LastRunTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_run_time", nil)
+	}
+
+	d.Set("name", resp.Name)
+
+	if err = d.Set("s3_job_definition", flattenS3JobDefinition(resp.S3JobDefinition)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie ClassificationJob (%s): %w", "s3_job_definition", d.Id(), err))
+	}
+
+	d.Set("sampling_percentage", resp.SamplingPercentage)
+
+	if err = d.Set("statistics", flattenMacie2ClassificationJobStatistics(resp.Statistics)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie ClassificationJob (%s): %w", "statistics", d.Id(), err))
+	}
+
+	if err = d.Set("tags", keyvaluetags.Macie2KeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie ClassificationJob (%s): %w", "tags", d.Id(), err))
+	}
+
+	return nil
+}
+
+func flattenMacie2ClassificationJobStatistics(statistics *macie2.Statistics) []map[string]interface{} {
+	if statistics == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"approximate_number_of_objects_to_process": aws.Float64Value(statistics.ApproximateNumberOfObjectsToProcess),
+			"number_of_runs": aws.Float64Value(statistics.NumberOfRuns),
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccAwsMacie2ClassificationJobDataSource_basic(t *testing.T) {
+	resourceName := "aws_macie2_classification_job.test"
+	dataSourceName := "data.aws_macie2_classification_job.test"
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsMacie2ClassificationJobDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobDataSourceConfigBasic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "job_arn", resourceName, "job_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "job_type", resourceName, "job_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sampling_percentage", resourceName, "sampling_percentage"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_job_definition.0.bucket_definitions.0.buckets.0", resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJobDataSourceConfigBasic(bucketName string) string {
+	return composeConfig(testAccAwsMacieClassificationJobconfigNameGenerated(bucketName, macie2.JobTypeOneTime), `
+data "aws_macie2_classification_job" "test" {
+  job_id = aws_macie2_classification_job.test.id
+}
+`)
+}
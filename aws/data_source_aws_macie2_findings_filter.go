@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsMacie2FindingsFilter() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsMacie2FindingsFilterRead,
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criterion": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"eq_exact_match": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"eq": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"neq": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"lt": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"lte": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"gt": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"gte": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"position": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsMacie2FindingsFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	id := d.Get("id").(string)
+
+	resp, err := conn.GetFindingsFilterWithContext(ctx, &macie2.GetFindingsFilterInput{
+		Id: aws.String(id),
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie FindingsFilter (%s): %w", id, err))
+	}
+
+	d.SetId(aws.StringValue(resp.Id))
+
+	if err = d.Set("finding_criteria", flattenFindingCriteriaFindingsFilter(resp.FindingCriteria)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie FindingsFilter (%s): %w", "finding_criteria", d.Id(), err))
+	}
+
+	d.Set("action", resp.Action)
+	d.Set("arn", resp.Arn)
+	d.Set("description", resp.Description)
+	d.Set("name", resp.Name)
+	d.Set("position", resp.Position)
+
+	if err = d.Set("tags", keyvaluetags.Macie2KeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie FindingsFilter (%s): %w", "tags", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccAwsMacie2FindingsFilterDataSource_basic(t *testing.T) {
+	resourceName := "aws_macie2_findings_filter.test"
+	dataSourceName := "data.aws_macie2_findings_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsMacie2AccountDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2FindingsFilterDataSourceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "action", resourceName, "action"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "position", resourceName, "position"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "finding_criteria.#", resourceName, "finding_criteria.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "finding_criteria.0.criterion.#", resourceName, "finding_criteria.0.criterion.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Key", "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2FindingsFilterDataSourceConfigBasic(name string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_findings_filter" "test" {
+  name        = %[1]q
+  description = "test"
+  action      = "ARCHIVE"
+  finding_criteria {
+    criterion {
+      field = "region"
+      eq    = [data.aws_region.current.name]
+    }
+  }
+  tags = {
+    Key = "value"
+  }
+  depends_on = [aws_macie2_account.test]
+}
+
+data "aws_macie2_findings_filter" "test" {
+  id = aws_macie2_findings_filter.test.id
+}
+`, name)
+}
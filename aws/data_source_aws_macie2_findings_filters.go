@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func dataSourceAwsMacie2FindingsFilters() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsMacie2FindingsFiltersRead,
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsMacie2FindingsFiltersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+
+	findingsFilters, err := finder.FindingsFilters(conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Macie FindingsFilters: %w", err))
+	}
+
+	// ListFindingsFilters does not support filtering, the name is matched client-side.
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	var arns, ids, names []string
+
+	for _, findingsFilter := range findingsFilters {
+		name := aws.StringValue(findingsFilter.Name)
+
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+
+		arns = append(arns, aws.StringValue(findingsFilter.Arn))
+		ids = append(ids, aws.StringValue(findingsFilter.Id))
+		names = append(names, name)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
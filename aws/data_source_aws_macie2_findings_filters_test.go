@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccAwsMacie2FindingsFiltersDataSource_basic(t *testing.T) {
+	resourceName := "aws_macie2_findings_filter.test"
+	dataSourceName := "data.aws_macie2_findings_filters.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsMacie2AccountDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2FindingsFiltersDataSourceConfigNameRegex(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2FindingsFiltersDataSourceConfigNameRegex(name string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_findings_filter" "test" {
+  name   = %[1]q
+  action = "ARCHIVE"
+  finding_criteria {
+    criterion {
+      field = "region"
+    }
+  }
+  depends_on = [aws_macie2_account.test]
+}
+
+resource "aws_macie2_findings_filter" "other" {
+  name   = "%[1]s-other"
+  action = "ARCHIVE"
+  finding_criteria {
+    criterion {
+      field = "region"
+    }
+  }
+  depends_on = [aws_macie2_account.test]
+}
+
+data "aws_macie2_findings_filters" "test" {
+  name_regex = "^%[1]s$"
+
+  depends_on = [aws_macie2_findings_filter.test, aws_macie2_findings_filter.other]
+}
+`, name)
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func dataSourceAwsMacie2Members() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsMacie2MembersRead,
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invited_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"relationship_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"only_associated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"relationship_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(macie2.RelationshipStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceAwsMacie2MembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+
+	members, err := finder.Members(conn, d.Get("only_associated").(bool))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Macie Members: %w", err))
+	}
+
+	// ListMembers has no relationship status filter, so the members are filtered once all the pages are listed.
+	members = macie2MembersFilterByRelationshipStatus(members, d.Get("relationship_status").(string))
+
+	var accountIDs []string
+	var tfList []interface{}
+
+	for _, member := range members {
+		accountIDs = append(accountIDs, aws.StringValue(member.AccountId))
+
+		tfMap := map[string]interface{}{
+			"account_id":          aws.StringValue(member.AccountId),
+			"arn":                 aws.StringValue(member.Arn),
+			"email":               aws.StringValue(member.Email),
+			"relationship_status": aws.StringValue(member.RelationshipStatus),
+		}
+
+		if member.InvitedAt != nil {
+			tfMap["invited_at"] = aws.TimeValue(member.InvitedAt).Format(time.RFC3339)
+		}
+
+		if member.UpdatedAt != nil {
+			tfMap["updated_at"] = aws.TimeValue(member.UpdatedAt).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("account_ids", accountIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting account_ids: %w", err))
+	}
+
+	if err := d.Set("members", tfList); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting members: %w", err))
+	}
+
+	return nil
+}
+
+// macie2MembersFilterByRelationshipStatus returns the members with the specified relationship status, or all the members when relationshipStatus is empty.
+func macie2MembersFilterByRelationshipStatus(members []*macie2.Member, relationshipStatus string) []*macie2.Member {
+	if relationshipStatus == "" {
+		return members
+	}
+
+	var filtered []*macie2.Member
+
+	for _, member := range members {
+		if aws.StringValue(member.RelationshipStatus) == relationshipStatus {
+			filtered = append(filtered, member)
+		}
+	}
+
+	return filtered
+}
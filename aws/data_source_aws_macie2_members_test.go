@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+func TestMacie2MembersFilterByRelationshipStatus(t *testing.T) {
+	created := &macie2.Member{
+		AccountId:          aws.String("123456789012"),
+		RelationshipStatus: aws.String(macie2.RelationshipStatusCreated),
+	}
+	enabled := &macie2.Member{
+		AccountId:          aws.String("210987654321"),
+		RelationshipStatus: aws.String(macie2.RelationshipStatusEnabled),
+	}
+	members := []*macie2.Member{created, enabled}
+
+	testCases := []struct {
+		Name               string
+		RelationshipStatus string
+		Expected           []*macie2.Member
+	}{
+		{
+			Name:     "no relationship status",
+			Expected: members,
+		},
+		{
+			Name:               "enabled",
+			RelationshipStatus: macie2.RelationshipStatusEnabled,
+			Expected:           []*macie2.Member{enabled},
+		},
+		{
+			Name:               "no match",
+			RelationshipStatus: macie2.RelationshipStatusPaused,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := macie2MembersFilterByRelationshipStatus(members, testCase.RelationshipStatus)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccAwsMacie2MembersDataSource_basic(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_macie2_members.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2AlternateEmail, EnvVarMacie2AlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MembersDataSourceConfigRelationshipStatus(email, macie2.RelationshipStatusInvited),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_ids.0", "aws_macie2_member.member", "account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members.0.arn", "aws_macie2_member.member", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.relationship_status", macie2.RelationshipStatusInvited),
+					testAccCheckResourceAttrRfc3339(dataSourceName, "members.0.invited_at"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2MembersDataSourceConfigRelationshipStatus(email, macie2.RelationshipStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2MembersDataSourceConfigRelationshipStatus(email, relationshipStatus string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_account" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_member" "member" {
+  account_id         = data.aws_caller_identity.member.account_id
+  email              = %[1]q
+  invite             = true
+  invitation_message = "This is a message of the invitation"
+  depends_on         = [aws_macie2_account.admin]
+}
+
+data "aws_macie2_members" "test" {
+  relationship_status = %[2]q
+
+  depends_on = [aws_macie2_member.member]
+}
+`, email, relationshipStatus)
+}
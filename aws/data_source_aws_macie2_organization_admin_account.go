@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func dataSourceAwsMacie2OrganizationAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsMacie2OrganizationAdminAccountRead,
+		Schema: map[string]*schema.Schema{
+			"admin_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsMacie2OrganizationAdminAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+
+	adminAccounts, err := finder.OrganizationAdminAccounts(conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Macie Organization Admin Accounts: %w", err))
+	}
+
+	adminAccount, err := macie2OrganizationAdminAccount(adminAccounts)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(aws.StringValue(adminAccount.AccountId))
+	d.Set("admin_account_id", adminAccount.AccountId)
+	d.Set("status", adminAccount.Status)
+
+	return nil
+}
+
+// macie2OrganizationAdminAccount returns the enabled delegated administrator account of the organization.
+// An organization has at most one enabled delegated administrator account, the other accounts are being disabled.
+func macie2OrganizationAdminAccount(adminAccounts []*macie2.AdminAccount) (*macie2.AdminAccount, error) {
+	for _, adminAccount := range adminAccounts {
+		if aws.StringValue(adminAccount.Status) == macie2.AdminStatusEnabled {
+			return adminAccount, nil
+		}
+	}
+
+	return nil, errors.New("no delegated Macie administrator account is registered for the organization")
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestMacie2OrganizationAdminAccount(t *testing.T) {
+	enabled := &macie2.AdminAccount{
+		AccountId: aws.String("123456789012"),
+		Status:    aws.String(macie2.AdminStatusEnabled),
+	}
+	disabling := &macie2.AdminAccount{
+		AccountId: aws.String("210987654321"),
+		Status:    aws.String(macie2.AdminStatusDisablingInProgress),
+	}
+
+	testCases := []struct {
+		Name          string
+		AdminAccounts []*macie2.AdminAccount
+		Expected      *macie2.AdminAccount
+		ExpectedError bool
+	}{
+		{
+			Name:          "no admin accounts",
+			ExpectedError: true,
+		},
+		{
+			Name:          "disabling admin account",
+			AdminAccounts: []*macie2.AdminAccount{disabling},
+			ExpectedError: true,
+		},
+		{
+			Name:          "enabled admin account",
+			AdminAccounts: []*macie2.AdminAccount{disabling, enabled},
+			Expected:      enabled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := macie2OrganizationAdminAccount(testCase.AdminAccounts)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccAwsMacie2OrganizationAdminAccountDataSource_basic(t *testing.T) {
+	resourceName := "aws_macie2_organization_admin_account.test"
+	dataSourceName := "data.aws_macie2_organization_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsMacie2OrganizationAdminAccountDestroy,
+		ErrorCheck:        testAccErrorCheckSkipMacie2OrganizationAdminAccount(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2OrganizationAdminAccountDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "admin_account_id", resourceName, "admin_account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", macie2.AdminStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2OrganizationAdminAccountDataSourceConfigBasic() string {
+	return testAccAwsMacieOrganizationAdminAccountConfigBasic() + `
+data "aws_macie2_organization_admin_account" "test" {
+  depends_on = [aws_macie2_organization_admin_account.test]
+}
+`
+}
@@ -50,6 +50,7 @@ var serviceNames = []string{
 	"dataexchange",
 	"datasync",
 	"dax",
+	"detective",
 	"devicefarm",
 	"directconnect",
 	"directoryservice",
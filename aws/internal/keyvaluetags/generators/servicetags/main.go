@@ -122,6 +122,7 @@ var mapServiceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"dataexchange",
+	"detective",
 	"dlm",
 	"eks",
 	"glacier",
@@ -52,6 +52,7 @@ var serviceNames = []string{
 	"datapipeline",
 	"datasync",
 	"dax",
+	"detective",
 	"devicefarm",
 	"directconnect",
 	"directoryservice",
@@ -90,6 +91,7 @@ var serviceNames = []string{
 	"lambda",
 	"licensemanager",
 	"lightsail",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
@@ -41,6 +41,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
@@ -204,6 +205,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(datasync.New)
 	case "dax":
 		funcType = reflect.TypeOf(dax.New)
+	case "detective":
+		funcType = reflect.TypeOf(detective.New)
 	case "devicefarm":
 		funcType = reflect.TypeOf(devicefarm.New)
 	case "directconnect":
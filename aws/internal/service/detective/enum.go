@@ -0,0 +1,21 @@
+package detective
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+)
+
+// MemberDisabledReasonDescription returns a human-readable description of the reason why a member account is disabled.
+func MemberDisabledReasonDescription(reason string) string {
+	switch reason {
+	case "":
+		return ""
+	case detective.MemberDisabledReasonVolumeTooHigh:
+		return "The member account was disabled because adding its data would cause the behavior graph to exceed the maximum allowed data volume."
+	case detective.MemberDisabledReasonVolumeUnknown:
+		return "The member account was disabled because Detective is unable to verify the data volume of the member account."
+	default:
+		return fmt.Sprintf("The member account was disabled (%s).", reason)
+	}
+}
@@ -0,0 +1,46 @@
+package detective
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+)
+
+func TestMemberDisabledReasonDescription(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Reason   string
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Reason:   "",
+			Expected: "",
+		},
+		{
+			Name:     "volume too high",
+			Reason:   detective.MemberDisabledReasonVolumeTooHigh,
+			Expected: "The member account was disabled because adding its data would cause the behavior graph to exceed the maximum allowed data volume.",
+		},
+		{
+			Name:     "volume unknown",
+			Reason:   detective.MemberDisabledReasonVolumeUnknown,
+			Expected: "The member account was disabled because Detective is unable to verify the data volume of the member account.",
+		},
+		{
+			Name:     "unknown reason",
+			Reason:   "SOMETHING_NEW",
+			Expected: "The member account was disabled (SOMETHING_NEW).",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := MemberDisabledReasonDescription(testCase.Reason)
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package detective
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+const (
+	// ErrCodeConcurrentModificationException is returned when the invitation is still being processed.
+	// It is not modeled in the AWS SDK for Go.
+	ErrCodeConcurrentModificationException = "ConcurrentModificationException"
+
+	// ErrCodeThrottlingException and ErrCodeTooManyRequestsException are returned when API requests are throttled.
+	ErrCodeThrottlingException      = "ThrottlingException"
+	ErrCodeTooManyRequestsException = "TooManyRequestsException"
+)
+
+// IsThrottlingError returns whether the error is returned when API requests are throttled.
+// Throttled requests are retried by every operation.
+func IsThrottlingError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, ErrCodeThrottlingException) ||
+		tfawserr.ErrCodeEquals(err, ErrCodeTooManyRequestsException)
+}
+
+// IsNotFoundError returns whether the error indicates that the Detective resource does not exist.
+func IsNotFoundError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException)
+}
+
+// IsInvitationSettlingError returns whether the error is returned while a sent invitation is still being processed.
+// These errors are only retried when accepting an invitation, a conflict is permanent for the other operations.
+func IsInvitationSettlingError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, detective.ErrCodeConflictException) ||
+		tfawserr.ErrCodeEquals(err, ErrCodeConcurrentModificationException)
+}
+
+// unprocessedAccountGuidance maps the common reasons an account is not processed, matched case-insensitively
+// in the reason of the unprocessed account, to the action that resolves them.
+var unprocessedAccountGuidance = []struct {
+	Reason   string
+	Guidance string
+}{
+	{"already a member", "the account is already a member of the behavior graph, import the existing member"},
+	{"email", "check that the email address is the email address of the root user of the account"},
+	{"suspended", "the account is suspended, reactivate the account before inviting it again"},
+	{"region", "the AWS Region is disabled in the account, enable the Region in the account before inviting it again"},
+}
+
+// UnprocessedAccountGuidance returns the action that resolves the reason an account is not processed,
+// or an empty string when the reason is not a common one.
+func UnprocessedAccountGuidance(reason string) string {
+	reason = strings.ToLower(reason)
+
+	for _, v := range unprocessedAccountGuidance {
+		if strings.Contains(reason, v.Reason) {
+			return v.Guidance
+		}
+	}
+
+	return ""
+}
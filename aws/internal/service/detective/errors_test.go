@@ -0,0 +1,109 @@
+package detective_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/detective"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+)
+
+func TestIsRetryableErrors(t *testing.T) {
+	testCases := []struct {
+		TestName                   string
+		Err                        error
+		ExpectedThrottling         bool
+		ExpectedInvitationSettling bool
+		ExpectedNotFound           bool
+	}{
+		{
+			TestName: "nil error",
+		},
+		{
+			TestName: "other error",
+			Err:      errors.New("test"),
+		},
+		{
+			TestName:           "throttling",
+			Err:                awserr.New(tfdetective.ErrCodeThrottlingException, "Rate exceeded", nil),
+			ExpectedThrottling: true,
+		},
+		{
+			TestName:           "too many requests",
+			Err:                awserr.New(tfdetective.ErrCodeTooManyRequestsException, "Too many requests", nil),
+			ExpectedThrottling: true,
+		},
+		{
+			TestName:                   "conflict",
+			Err:                        awserr.New(detective.ErrCodeConflictException, "The request conflicts with the current state", nil),
+			ExpectedInvitationSettling: true,
+		},
+		{
+			TestName:                   "concurrent modification",
+			Err:                        awserr.New(tfdetective.ErrCodeConcurrentModificationException, "The invitation is being processed", nil),
+			ExpectedInvitationSettling: true,
+		},
+		{
+			TestName: "validation",
+			Err:      awserr.New(detective.ErrCodeValidationException, "The request is invalid", nil),
+		},
+		{
+			TestName:         "resource not found",
+			Err:              awserr.New(detective.ErrCodeResourceNotFoundException, "The request refers to a nonexistent resource", nil),
+			ExpectedNotFound: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := tfdetective.IsThrottlingError(testCase.Err); got != testCase.ExpectedThrottling {
+				t.Errorf("IsThrottlingError got %t, expected %t", got, testCase.ExpectedThrottling)
+			}
+
+			if got := tfdetective.IsInvitationSettlingError(testCase.Err); got != testCase.ExpectedInvitationSettling {
+				t.Errorf("IsInvitationSettlingError got %t, expected %t", got, testCase.ExpectedInvitationSettling)
+			}
+
+			if got := tfdetective.IsNotFoundError(testCase.Err); got != testCase.ExpectedNotFound {
+				t.Errorf("IsNotFoundError got %t, expected %t", got, testCase.ExpectedNotFound)
+			}
+		})
+	}
+}
+
+func TestUnprocessedAccountGuidance(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Reason   string
+		Expected string
+	}{
+		{
+			TestName: "already a member",
+			Reason:   "The request is rejected because the account is already a member",
+			Expected: "the account is already a member of the behavior graph, import the existing member",
+		},
+		{
+			TestName: "invalid email",
+			Reason:   "The request is rejected because the email address is not valid",
+			Expected: "check that the email address is the email address of the root user of the account",
+		},
+		{
+			TestName: "account suspended",
+			Reason:   "The account is suspended",
+			Expected: "the account is suspended, reactivate the account before inviting it again",
+		},
+		{
+			TestName: "unknown reason",
+			Reason:   "Something went wrong",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := tfdetective.UnprocessedAccountGuidance(testCase.Reason); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Graph returns the behavior graph of the calling account in the current Region.
+// Returns NotFoundError if the account is not an administrator of any behavior graph.
+func Graph(ctx context.Context, conn *detective.Detective) (*detective.Graph, error) {
+	graphs, err := Graphs(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(graphs) == 0 {
+		return nil, &resource.NotFoundError{
+			Message: "Empty result",
+		}
+	}
+
+	return graphs[0], nil
+}
+
+// GraphByARN returns the behavior graph of the calling account matching the specified graph ARN.
+// Returns NotFoundError if no matching behavior graph is listed.
+func GraphByARN(ctx context.Context, conn *detective.Detective, graphARN string) (*detective.Graph, error) {
+	graphs, err := Graphs(ctx, conn)
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, graph := range graphs {
+		if aws.StringValue(graph.Arn) == graphARN {
+			return graph, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: "Empty result",
+	}
+}
+
+// Graphs returns the behavior graphs of the calling account in the current Region.
+func Graphs(ctx context.Context, conn *detective.Detective) ([]*detective.Graph, error) {
+	input := &detective.ListGraphsInput{}
+	var result []*detective.Graph
+
+	err := conn.ListGraphsPagesWithContext(ctx, input, func(page *detective.ListGraphsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, graph := range page.GraphList {
+			if graph == nil {
+				continue
+			}
+
+			result = append(result, graph)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// InvitationByGraphARN returns the behavior graph invitation for the calling member account
+// matching the specified graph ARN. Returns NotFoundError if no matching invitation is listed.
+func InvitationByGraphARN(ctx context.Context, conn *detective.Detective, graphARN string) (*detective.MemberDetail, error) {
+	input := &detective.ListInvitationsInput{}
+	var result *detective.MemberDetail
+
+	err := conn.ListInvitationsPagesWithContext(ctx, input, func(page *detective.ListInvitationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, invitation := range page.Invitations {
+			if invitation == nil {
+				continue
+			}
+
+			if aws.StringValue(invitation.GraphArn) == graphARN {
+				result = invitation
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "no invitation found for graph " + graphARN,
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// Invitations returns the behavior graph invitations of the calling member account.
+func Invitations(ctx context.Context, conn *detective.Detective) ([]*detective.MemberDetail, error) {
+	input := &detective.ListInvitationsInput{}
+	var result []*detective.MemberDetail
+
+	err := conn.ListInvitationsPagesWithContext(ctx, input, func(page *detective.ListInvitationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, invitation := range page.Invitations {
+			if invitation == nil {
+				continue
+			}
+
+			result = append(result, invitation)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// MemberByGraphARNAndAccountID returns the member account of the behavior graph matching the specified account ID.
+// Returns NotFoundError if the graph or member does not exist, including when GetMembers succeeds without member details.
+func MemberByGraphARNAndAccountID(ctx context.Context, conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	input := &detective.GetMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	}
+
+	output, err := conn.GetMembersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.MemberDetails) == 0 || output.MemberDetails[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.MemberDetails[0], nil
+}
+
+// Members returns the member accounts of the behavior graph.
+func Members(ctx context.Context, conn *detective.Detective, graphARN string) ([]*detective.MemberDetail, error) {
+	input := &detective.ListMembersInput{
+		GraphArn: aws.String(graphARN),
+	}
+	var result []*detective.MemberDetail
+
+	err := conn.ListMembersPagesWithContext(ctx, input, func(page *detective.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, member := range page.MemberDetails {
+			if member == nil {
+				continue
+			}
+
+			result = append(result, member)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package finder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestMemberByGraphARNAndAccountID(t *testing.T) {
+	member := &detective.MemberDetail{
+		AccountId: aws.String("123456789012"),
+		GraphArn:  aws.String("arn:aws:detective:us-east-1:123456789012:graph:a"), //lintignore:AWSAT003,AWSAT005
+	}
+
+	testCases := []struct {
+		Name             string
+		MemberDetails    []*detective.MemberDetail
+		ExpectedMember   *detective.MemberDetail
+		ExpectedNotFound bool
+	}{
+		{
+			Name:             "empty member details",
+			MemberDetails:    []*detective.MemberDetail{},
+			ExpectedNotFound: true,
+		},
+		{
+			Name:             "nil member details",
+			ExpectedNotFound: true,
+		},
+		{
+			Name:             "nil member detail",
+			MemberDetails:    []*detective.MemberDetail{nil},
+			ExpectedNotFound: true,
+		},
+		{
+			Name:           "member",
+			MemberDetails:  []*detective.MemberDetail{member},
+			ExpectedMember: member,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := detective.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				data := r.Data.(*detective.GetMembersOutput)
+				data.MemberDetails = testCase.MemberDetails
+			})
+
+			got, err := MemberByGraphARNAndAccountID(context.Background(), conn, "arn:aws:detective:us-east-1:123456789012:graph:a", "123456789012") //lintignore:AWSAT003,AWSAT005
+
+			if testCase.ExpectedNotFound {
+				if !tfresource.NotFound(err) {
+					t.Errorf("expected NotFoundError, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedMember {
+				t.Errorf("got %v, expected %v", got, testCase.ExpectedMember)
+			}
+		})
+	}
+}
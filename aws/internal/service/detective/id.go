@@ -0,0 +1,70 @@
+package detective
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/detective"
+)
+
+const invitationAcceptIDSeparator = ","
+
+const graphARNResourcePrefix = "graph:"
+
+var graphIDRegexp = regexp.MustCompile(`^[0-9a-z]+$`)
+
+// InvitationAcceptCreateID returns the ID of an invitation accept for the specified graph ARNs.
+// The ID of an invitation accept for a single graph is the graph ARN.
+func InvitationAcceptCreateID(graphARNs []string) string {
+	parts := make([]string, len(graphARNs))
+	copy(parts, graphARNs)
+	sort.Strings(parts)
+
+	return strings.Join(parts, invitationAcceptIDSeparator)
+}
+
+// InvitationAcceptParseID returns the graph ARNs of an invitation accept ID.
+func InvitationAcceptParseID(id string) ([]string, error) {
+	parts := strings.Split(id, invitationAcceptIDSeparator)
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected graph_arn[,graph_arn...]", id)
+		}
+	}
+
+	return parts, nil
+}
+
+// GraphImportARN returns the graph ARN of an import ID, which is either a graph ARN or the short graph ID
+// that follows "graph:" in the ARN. The ARN of a short graph ID is built from the specified partition, Region and account.
+func GraphImportARN(id, partition, region, accountID string) (string, error) {
+	if arn.IsARN(id) {
+		parsedARN, err := arn.Parse(id)
+
+		if err != nil {
+			return "", fmt.Errorf("error parsing graph ARN (%s): %w", id, err)
+		}
+
+		if parsedARN.Service != detective.ServiceName || !strings.HasPrefix(parsedARN.Resource, graphARNResourcePrefix) {
+			return "", fmt.Errorf("unexpected format of ID (%s), expected graph ARN or graph ID", id)
+		}
+
+		return id, nil
+	}
+
+	if !graphIDRegexp.MatchString(id) {
+		return "", fmt.Errorf("unexpected format of ID (%s), expected graph ARN or graph ID", id)
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   detective.ServiceName,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  graphARNResourcePrefix + id,
+	}.String(), nil
+}
@@ -0,0 +1,143 @@
+package detective_test
+
+import (
+	"reflect"
+	"testing"
+
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+)
+
+func TestInvitationAcceptCreateID(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		GraphARNs  []string
+		ExpectedID string
+	}{
+		{
+			TestName:   "single graph",
+			GraphARNs:  []string{"arn:aws:detective:us-east-1:123456789012:graph:b"}, //lintignore:AWSAT003,AWSAT005
+			ExpectedID: "arn:aws:detective:us-east-1:123456789012:graph:b",           //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:   "multiple graphs",
+			GraphARNs:  []string{"arn:aws:detective:us-east-1:123456789012:graph:b", "arn:aws:detective:us-east-1:210987654321:graph:a"}, //lintignore:AWSAT003,AWSAT005
+			ExpectedID: "arn:aws:detective:us-east-1:123456789012:graph:b,arn:aws:detective:us-east-1:210987654321:graph:a",              //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:   "unsorted graphs",
+			GraphARNs:  []string{"arn:aws:detective:us-west-2:123456789012:graph:b", "arn:aws:detective:us-east-1:210987654321:graph:a"}, //lintignore:AWSAT003,AWSAT005
+			ExpectedID: "arn:aws:detective:us-east-1:210987654321:graph:a,arn:aws:detective:us-west-2:123456789012:graph:b",              //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := tfdetective.InvitationAcceptCreateID(testCase.GraphARNs)
+
+			if got != testCase.ExpectedID {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
+
+func TestInvitationAcceptParseID(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		InputID           string
+		ExpectedError     bool
+		ExpectedGraphARNs []string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:          "single graph",
+			InputID:           "arn:aws:detective:us-east-1:123456789012:graph:a",           //lintignore:AWSAT003,AWSAT005
+			ExpectedGraphARNs: []string{"arn:aws:detective:us-east-1:123456789012:graph:a"}, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:          "multiple graphs",
+			InputID:           "arn:aws:detective:us-east-1:123456789012:graph:a,arn:aws:detective:us-east-1:210987654321:graph:b",              //lintignore:AWSAT003,AWSAT005
+			ExpectedGraphARNs: []string{"arn:aws:detective:us-east-1:123456789012:graph:a", "arn:aws:detective:us-east-1:210987654321:graph:b"}, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:      "empty part",
+			InputID:       "arn:aws:detective:us-east-1:123456789012:graph:a,", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfdetective.InvitationAcceptParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedGraphARNs) {
+				t.Errorf("got %v, expected %v", got, testCase.ExpectedGraphARNs)
+			}
+		})
+	}
+}
+
+func TestGraphImportARN(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		InputID          string
+		ExpectedError    bool
+		ExpectedGraphARN string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:         "graph ARN",
+			InputID:          "arn:aws:detective:us-west-2:210987654321:graph:abcdef0123456789abcdef0123456789", //lintignore:AWSAT003,AWSAT005
+			ExpectedGraphARN: "arn:aws:detective:us-west-2:210987654321:graph:abcdef0123456789abcdef0123456789", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:         "graph ID",
+			InputID:          "abcdef0123456789abcdef0123456789",
+			ExpectedGraphARN: "arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789abcdef0123456789", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:      "ARN of another service",
+			InputID:       "arn:aws:macie2:us-east-1:123456789012:member/210987654321", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid graph ID",
+			InputID:       "graph:abcdef0123456789abcdef0123456789",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfdetective.GraphImportARN(testCase.InputID, "aws", "us-east-1", "123456789012") //lintignore:AWSAT003
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedGraphARN {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedGraphARN)
+			}
+		})
+	}
+}
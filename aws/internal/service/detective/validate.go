@@ -0,0 +1,49 @@
+package detective
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Tag keys and values may contain Unicode letters, digits, white space and the _ . : / = + - @ characters.
+var graphTagRegexp = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+
+// ValidateGraphARNRegion returns an error when the behavior graph ARN is in a Region other than the specified Region.
+// Behavior graphs are regional and can only be managed through the endpoint of their Region.
+// ARNs that cannot be parsed, such as values not yet known, are not validated.
+func ValidateGraphARNRegion(graphARN, region string) error {
+	parsedARN, err := arn.Parse(graphARN)
+
+	if err != nil {
+		return nil
+	}
+
+	if parsedARN.Region != region {
+		return fmt.Errorf("behavior graph (%s) is in Region %s, not in the provider Region %s", graphARN, parsedARN.Region, region)
+	}
+
+	return nil
+}
+
+// ValidateGraphTags validates the tags of a behavior graph against the limits enforced by Detective:
+// keys are 1 to 128 characters long and values at most 256 characters long.
+func ValidateGraphTags(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, f := range []schema.SchemaValidateDiagFunc{
+		validation.MapKeyLenBetween(1, 128),
+		validation.MapValueLenBetween(0, 256),
+		validation.MapKeyMatch(graphTagRegexp, "tag keys can only contain Unicode letters, digits, white space and _ . : / = + - @"),
+		validation.MapValueMatch(graphTagRegexp, "tag values can only contain Unicode letters, digits, white space and _ . : / = + - @"),
+	} {
+		diags = append(diags, f(v, path)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,121 @@
+package detective_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+)
+
+func TestValidateGraphARNRegion(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		GraphARN      string
+		Region        string
+		ExpectedError bool
+	}{
+		{
+			TestName: "same Region",
+			GraphARN: "arn:aws:detective:us-west-2:123456789012:graph:abcdef0123456789abcdef0123456789",
+			Region:   "us-west-2",
+		},
+		{
+			TestName:      "other Region",
+			GraphARN:      "arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789abcdef0123456789",
+			Region:        "us-west-2",
+			ExpectedError: true,
+		},
+		{
+			TestName: "not an ARN",
+			GraphARN: "74D93920-ED26-11E3-AC10-0800200C9A66",
+			Region:   "us-west-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := tfdetective.ValidateGraphARNRegion(testCase.GraphARN, testCase.Region)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateGraphTags(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Tags          map[string]interface{}
+		ExpectedError bool
+	}{
+		{
+			TestName: "no tags",
+			Tags:     map[string]interface{}{},
+		},
+		{
+			TestName: "valid tags",
+			Tags: map[string]interface{}{
+				"Name":               "test graph",
+				"team:cost-center/1": "a+b=c@d.e_f",
+				"empty":              "",
+			},
+		},
+		{
+			TestName: "maximum lengths",
+			Tags: map[string]interface{}{
+				strings.Repeat("k", 128): strings.Repeat("v", 256),
+			},
+		},
+		{
+			TestName: "over-length key",
+			Tags: map[string]interface{}{
+				strings.Repeat("k", 129): "value",
+			},
+			ExpectedError: true,
+		},
+		{
+			TestName: "over-length value",
+			Tags: map[string]interface{}{
+				"Name": strings.Repeat("v", 257),
+			},
+			ExpectedError: true,
+		},
+		{
+			TestName: "empty key",
+			Tags: map[string]interface{}{
+				"": "value",
+			},
+			ExpectedError: true,
+		},
+		{
+			TestName: "invalid key characters",
+			Tags: map[string]interface{}{
+				"Name*": "value",
+			},
+			ExpectedError: true,
+		},
+		{
+			TestName: "invalid value characters",
+			Tags: map[string]interface{}{
+				"Name": "value#1",
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			diags := tfdetective.ValidateGraphTags(testCase.Tags, cty.Path{cty.GetAttrStep{Name: "graph_tags"}})
+
+			if got := diags.HasError(); got != testCase.ExpectedError {
+				t.Errorf("got error %t, expected %t: %v", got, testCase.ExpectedError, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package waiter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// GraphStatusExists is the status of a behavior graph that is still listed
+	GraphStatusExists = "Exists"
+)
+
+// GraphStatus fetches the behavior graph and whether it is still listed
+func GraphStatus(ctx context.Context, conn *detective.Detective, graphARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		graph, err := finder.GraphByARN(ctx, conn, graphARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return graph, GraphStatusExists, nil
+	}
+}
+
+// MemberStatus fetches the behavior graph member and its status
+func MemberStatus(ctx context.Context, conn *detective.Detective, graphARN, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := finder.MemberByGraphARNAndAccountID(ctx, conn, graphARN, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return member, aws.StringValue(member.Status), nil
+	}
+}
+
+// InvitationStatus fetches the behavior graph invitation of the calling member account and its status
+func InvitationStatus(ctx context.Context, conn *detective.Detective, graphARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		invitation, err := finder.InvitationByGraphARN(ctx, conn, graphARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return invitation, aws.StringValue(invitation.Status), nil
+	}
+}
@@ -0,0 +1,97 @@
+package waiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Interval between two graph, member or invitation status checks
+	PollInterval = 10 * time.Second
+
+	// Initial delay before the first member status check
+	MemberInvitedDelay = 5 * time.Second
+)
+
+// GraphDeleted waits for a deleted behavior graph to no longer be listed, as behavior graphs are deleted asynchronously.
+// The timeout is what remains of the delete timeout of the graph, so that it can be raised for graphs with many members.
+func GraphDeleted(ctx context.Context, conn *detective.Detective, graphARN string, timeout time.Duration) (*detective.Graph, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{GraphStatusExists},
+		Target:       []string{},
+		Refresh:      GraphStatus(ctx, conn, graphARN),
+		Timeout:      timeout,
+		PollInterval: PollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*detective.Graph); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// MemberInvited waits for a behavior graph member to return Invited, Enabled or Accepted but disabled.
+// The timeout is the timeout of the resource operation that sent the invitation.
+func MemberInvited(ctx context.Context, conn *detective.Detective, graphARN, accountID string, timeout time.Duration) (*detective.MemberDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{detective.MemberStatusVerificationInProgress},
+		Target:       []string{detective.MemberStatusInvited, detective.MemberStatusEnabled, detective.MemberStatusAcceptedButDisabled},
+		Refresh:      MemberStatus(ctx, conn, graphARN, accountID),
+		Timeout:      timeout,
+		Delay:        MemberInvitedDelay,
+		PollInterval: PollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*detective.MemberDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// MemberVerified waits for the email verification of a behavior graph member to complete.
+func MemberVerified(ctx context.Context, conn *detective.Detective, graphARN, accountID string, timeout time.Duration) (*detective.MemberDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{detective.MemberStatusVerificationInProgress},
+		Target:       []string{detective.MemberStatusInvited, detective.MemberStatusEnabled, detective.MemberStatusAcceptedButDisabled, detective.MemberStatusVerificationFailed},
+		Refresh:      MemberStatus(ctx, conn, graphARN, accountID),
+		Timeout:      timeout,
+		PollInterval: PollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*detective.MemberDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InvitationAccepted waits for an accepted behavior graph invitation to return Enabled or Accepted but disabled.
+// A member can be disabled as soon as it is accepted when its data volume is too high for the graph, which is not an error.
+func InvitationAccepted(ctx context.Context, conn *detective.Detective, graphARN string, timeout time.Duration) (*detective.MemberDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{detective.MemberStatusInvited, detective.MemberStatusVerificationInProgress},
+		Target:       []string{detective.MemberStatusEnabled, detective.MemberStatusAcceptedButDisabled},
+		Refresh:      InvitationStatus(ctx, conn, graphARN),
+		Timeout:      timeout,
+		PollInterval: PollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*detective.MemberDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,118 @@
+package macie2
+
+import (
+	"github.com/aws/aws-sdk-go/service/macie2"
+)
+
+const (
+	MemberOnInviteFalseDisassociate = "disassociate"
+	MemberOnInviteFalsePause        = "pause"
+)
+
+func MemberOnInviteFalse_Values() []string {
+	return []string{
+		MemberOnInviteFalseDisassociate,
+		MemberOnInviteFalsePause,
+	}
+}
+
+// Normalized relationship states of a member account, see MemberState.
+const (
+	MemberStateAssociated    = "associated"
+	MemberStateInvited       = "invited"
+	MemberStateNotAssociated = "not_associated"
+	MemberStateUnknown       = "unknown"
+)
+
+func MemberState_Values() []string {
+	return []string{
+		MemberStateAssociated,
+		MemberStateInvited,
+		MemberStateNotAssociated,
+		MemberStateUnknown,
+	}
+}
+
+// MemberState returns the normalized state of a member account from its relationship status:
+// associated members are Enabled or Paused, invited members have a pending invitation, and members
+// that are not associated were never invited or left the administrator account.
+// The statuses in which the member cannot be managed, e.g. AccountSuspended, are unknown.
+func MemberState(relationshipStatus string) string {
+	switch relationshipStatus {
+	case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused:
+		return MemberStateAssociated
+	case macie2.RelationshipStatusInvited, macie2.RelationshipStatusEmailVerificationInProgress:
+		return MemberStateInvited
+	case macie2.RelationshipStatusCreated, macie2.RelationshipStatusRemoved, macie2.RelationshipStatusResigned:
+		return MemberStateNotAssociated
+	default:
+		return MemberStateUnknown
+	}
+}
+
+// Outcomes of a member session status change, see MemberStatusTransition.
+const (
+	// The session status is updated with UpdateMemberSession.
+	MemberStatusTransitionUpdate = "update"
+	// The session status is already the requested one.
+	MemberStatusTransitionNone = "none"
+	// The session status is updated once the member is associated, i.e. after the invitation is accepted.
+	MemberStatusTransitionDeferred = "deferred"
+	// The session status cannot be updated, UpdateMemberSession would reject the change.
+	MemberStatusTransitionInvalid = "invalid"
+)
+
+// memberStatusTransitions is the outcome of changing the session status of a member to Enabled or Paused by relationship status.
+var memberStatusTransitions = map[string]map[string]string{
+	macie2.RelationshipStatusEnabled: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionNone,
+		macie2.MacieStatusPaused:  MemberStatusTransitionUpdate,
+	},
+	macie2.RelationshipStatusPaused: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionUpdate,
+		macie2.MacieStatusPaused:  MemberStatusTransitionNone,
+	},
+	macie2.RelationshipStatusCreated: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionDeferred,
+		macie2.MacieStatusPaused:  MemberStatusTransitionDeferred,
+	},
+	macie2.RelationshipStatusInvited: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionDeferred,
+		macie2.MacieStatusPaused:  MemberStatusTransitionDeferred,
+	},
+	macie2.RelationshipStatusEmailVerificationInProgress: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionDeferred,
+		macie2.MacieStatusPaused:  MemberStatusTransitionDeferred,
+	},
+	macie2.RelationshipStatusRemoved: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionInvalid,
+		macie2.MacieStatusPaused:  MemberStatusTransitionInvalid,
+	},
+	macie2.RelationshipStatusResigned: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionInvalid,
+		macie2.MacieStatusPaused:  MemberStatusTransitionInvalid,
+	},
+	macie2.RelationshipStatusEmailVerificationFailed: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionInvalid,
+		macie2.MacieStatusPaused:  MemberStatusTransitionInvalid,
+	},
+	macie2.RelationshipStatusAccountSuspended: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionInvalid,
+		macie2.MacieStatusPaused:  MemberStatusTransitionInvalid,
+	},
+	macie2.RelationshipStatusRegionDisabled: {
+		macie2.MacieStatusEnabled: MemberStatusTransitionInvalid,
+		macie2.MacieStatusPaused:  MemberStatusTransitionInvalid,
+	},
+}
+
+// MemberStatusTransition returns the outcome of changing the session status of a member with the specified
+// relationship status. The relationship statuses that are not known yet are deferred, like pending invitations,
+// so that they are left to a later apply instead of being rejected.
+func MemberStatusTransition(relationshipStatus, status string) string {
+	if transition, ok := memberStatusTransitions[relationshipStatus][status]; ok {
+		return transition
+	}
+
+	return MemberStatusTransitionDeferred
+}
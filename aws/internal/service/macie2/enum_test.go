@@ -0,0 +1,174 @@
+package macie2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+)
+
+func TestMemberState(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		RelationshipStatus string
+		Expected           string
+	}{
+		{
+			Name:               "empty",
+			RelationshipStatus: "",
+			Expected:           MemberStateUnknown,
+		},
+		{
+			Name:               "enabled",
+			RelationshipStatus: macie2.RelationshipStatusEnabled,
+			Expected:           MemberStateAssociated,
+		},
+		{
+			Name:               "paused",
+			RelationshipStatus: macie2.RelationshipStatusPaused,
+			Expected:           MemberStateAssociated,
+		},
+		{
+			Name:               "invited",
+			RelationshipStatus: macie2.RelationshipStatusInvited,
+			Expected:           MemberStateInvited,
+		},
+		{
+			Name:               "email verification in progress",
+			RelationshipStatus: macie2.RelationshipStatusEmailVerificationInProgress,
+			Expected:           MemberStateInvited,
+		},
+		{
+			Name:               "created",
+			RelationshipStatus: macie2.RelationshipStatusCreated,
+			Expected:           MemberStateNotAssociated,
+		},
+		{
+			Name:               "removed",
+			RelationshipStatus: macie2.RelationshipStatusRemoved,
+			Expected:           MemberStateNotAssociated,
+		},
+		{
+			Name:               "resigned",
+			RelationshipStatus: macie2.RelationshipStatusResigned,
+			Expected:           MemberStateNotAssociated,
+		},
+		{
+			Name:               "email verification failed",
+			RelationshipStatus: macie2.RelationshipStatusEmailVerificationFailed,
+			Expected:           MemberStateUnknown,
+		},
+		{
+			Name:               "account suspended",
+			RelationshipStatus: macie2.RelationshipStatusAccountSuspended,
+			Expected:           MemberStateUnknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := MemberState(testCase.RelationshipStatus)
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMemberStatusTransition(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		RelationshipStatus string
+		Status             string
+		Expected           string
+	}{
+		{
+			Name:               "enabled to enabled",
+			RelationshipStatus: macie2.RelationshipStatusEnabled,
+			Status:             macie2.MacieStatusEnabled,
+			Expected:           MemberStatusTransitionNone,
+		},
+		{
+			Name:               "enabled to paused",
+			RelationshipStatus: macie2.RelationshipStatusEnabled,
+			Status:             macie2.MacieStatusPaused,
+			Expected:           MemberStatusTransitionUpdate,
+		},
+		{
+			Name:               "paused to enabled",
+			RelationshipStatus: macie2.RelationshipStatusPaused,
+			Status:             macie2.MacieStatusEnabled,
+			Expected:           MemberStatusTransitionUpdate,
+		},
+		{
+			Name:               "paused to paused",
+			RelationshipStatus: macie2.RelationshipStatusPaused,
+			Status:             macie2.MacieStatusPaused,
+			Expected:           MemberStatusTransitionNone,
+		},
+		{
+			Name:               "created to enabled",
+			RelationshipStatus: macie2.RelationshipStatusCreated,
+			Status:             macie2.MacieStatusEnabled,
+			Expected:           MemberStatusTransitionDeferred,
+		},
+		{
+			Name:               "invited to paused",
+			RelationshipStatus: macie2.RelationshipStatusInvited,
+			Status:             macie2.MacieStatusPaused,
+			Expected:           MemberStatusTransitionDeferred,
+		},
+		{
+			Name:               "email verification in progress to paused",
+			RelationshipStatus: macie2.RelationshipStatusEmailVerificationInProgress,
+			Status:             macie2.MacieStatusPaused,
+			Expected:           MemberStatusTransitionDeferred,
+		},
+		{
+			Name:               "removed to paused",
+			RelationshipStatus: macie2.RelationshipStatusRemoved,
+			Status:             macie2.MacieStatusPaused,
+			Expected:           MemberStatusTransitionInvalid,
+		},
+		{
+			Name:               "resigned to enabled",
+			RelationshipStatus: macie2.RelationshipStatusResigned,
+			Status:             macie2.MacieStatusEnabled,
+			Expected:           MemberStatusTransitionInvalid,
+		},
+		{
+			Name:               "email verification failed to enabled",
+			RelationshipStatus: macie2.RelationshipStatusEmailVerificationFailed,
+			Status:             macie2.MacieStatusEnabled,
+			Expected:           MemberStatusTransitionInvalid,
+		},
+		{
+			Name:               "account suspended to enabled",
+			RelationshipStatus: macie2.RelationshipStatusAccountSuspended,
+			Status:             macie2.MacieStatusEnabled,
+			Expected:           MemberStatusTransitionInvalid,
+		},
+		{
+			Name:               "region disabled to paused",
+			RelationshipStatus: macie2.RelationshipStatusRegionDisabled,
+			Status:             macie2.MacieStatusPaused,
+			Expected:           MemberStatusTransitionInvalid,
+		},
+		{
+			Name:               "unknown relationship status",
+			RelationshipStatus: "NewStatus",
+			Status:             macie2.MacieStatusPaused,
+			Expected:           MemberStatusTransitionDeferred,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := MemberStatusTransition(testCase.RelationshipStatus, testCase.Status)
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
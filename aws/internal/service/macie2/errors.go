@@ -0,0 +1,64 @@
+package macie2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// IsThrottlingError returns whether the error is returned when API requests are throttled.
+// Throttled requests are retried by every operation.
+func IsThrottlingError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, macie2.ErrCodeThrottlingException)
+}
+
+// IsNotFoundError returns whether the error indicates that the Macie resource does not exist,
+// including when Macie is not enabled for the account.
+func IsNotFoundError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")
+}
+
+// IsMemberCreationError returns whether the error is returned while creating or inviting a member
+// whose account is not ready yet, e.g. right after Macie is enabled in the account.
+func IsMemberCreationError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError)
+}
+
+// IsMemberAssociatedError returns whether the error is returned when deleting a member
+// that is still associated with the administrator account.
+func IsMemberAssociatedError(err error) bool {
+	return tfawserr.ErrMessageContains(err, macie2.ErrCodeConflictException, "member accounts are associated with your account")
+}
+
+// unprocessedAccountGuidance maps the common reasons an account is not processed, matched case-insensitively
+// in the error message of the unprocessed account, to the action that resolves them.
+var unprocessedAccountGuidance = []struct {
+	Reason   string
+	Guidance string
+}{
+	{"already a member", "the account is already a member of an administrator account, disassociate it from its current administrator account or import the existing member"},
+	{"already associated", "the account is already associated with an administrator account, disassociate it from its current administrator account or import the existing member"},
+	{"email", "check that the email address is the email address of the root user of the account"},
+	{"suspended", "the account is suspended, reactivate the account before inviting it again"},
+	{"region", "the AWS Region is disabled in the account, enable the Region in the account before inviting it again"},
+}
+
+// UnprocessedAccountGuidance returns the action that resolves the error of an account that is not processed,
+// or an empty string when the error is not a common one.
+func UnprocessedAccountGuidance(errorCode, errorMessage string) string {
+	message := strings.ToLower(errorMessage)
+
+	for _, v := range unprocessedAccountGuidance {
+		if strings.Contains(message, v.Reason) {
+			return v.Guidance
+		}
+	}
+
+	if errorCode == macie2.ErrorCodeInternalError {
+		return "the error is internal to Macie, retry the operation later"
+	}
+
+	return ""
+}
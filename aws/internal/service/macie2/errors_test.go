@@ -0,0 +1,128 @@
+package macie2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/macie2"
+)
+
+func TestIsRetryableErrors(t *testing.T) {
+	testCases := []struct {
+		TestName                 string
+		Err                      error
+		ExpectedThrottling       bool
+		ExpectedMemberCreation   bool
+		ExpectedMemberAssociated bool
+		ExpectedNotFound         bool
+	}{
+		{
+			TestName: "nil error",
+		},
+		{
+			TestName: "other error",
+			Err:      errors.New("test"),
+		},
+		{
+			TestName:           "throttling",
+			Err:                awserr.New(macie2.ErrCodeThrottlingException, "Rate exceeded", nil),
+			ExpectedThrottling: true,
+		},
+		{
+			TestName:               "client error",
+			Err:                    awserr.New(macie2.ErrorCodeClientError, "The account is not ready", nil),
+			ExpectedMemberCreation: true,
+		},
+		{
+			TestName:                 "member associated",
+			Err:                      awserr.New(macie2.ErrCodeConflictException, "The request failed because member accounts are associated with your account", nil),
+			ExpectedMemberAssociated: true,
+		},
+		{
+			TestName: "other conflict",
+			Err:      awserr.New(macie2.ErrCodeConflictException, "The request conflicts with the current state", nil),
+		},
+		{
+			TestName:         "resource not found",
+			Err:              awserr.New(macie2.ErrCodeResourceNotFoundException, "The request failed because the specified resource wasn't found", nil),
+			ExpectedNotFound: true,
+		},
+		{
+			TestName:         "Macie not enabled",
+			Err:              awserr.New(macie2.ErrCodeAccessDeniedException, "Macie is not enabled", nil),
+			ExpectedNotFound: true,
+		},
+		{
+			TestName: "other access denied",
+			Err:      awserr.New(macie2.ErrCodeAccessDeniedException, "User is not authorized to perform this action", nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := IsThrottlingError(testCase.Err); got != testCase.ExpectedThrottling {
+				t.Errorf("IsThrottlingError got %t, expected %t", got, testCase.ExpectedThrottling)
+			}
+
+			if got := IsMemberCreationError(testCase.Err); got != testCase.ExpectedMemberCreation {
+				t.Errorf("IsMemberCreationError got %t, expected %t", got, testCase.ExpectedMemberCreation)
+			}
+
+			if got := IsMemberAssociatedError(testCase.Err); got != testCase.ExpectedMemberAssociated {
+				t.Errorf("IsMemberAssociatedError got %t, expected %t", got, testCase.ExpectedMemberAssociated)
+			}
+
+			if got := IsNotFoundError(testCase.Err); got != testCase.ExpectedNotFound {
+				t.Errorf("IsNotFoundError got %t, expected %t", got, testCase.ExpectedNotFound)
+			}
+		})
+	}
+}
+
+func TestUnprocessedAccountGuidance(t *testing.T) {
+	testCases := []struct {
+		TestName     string
+		ErrorCode    string
+		ErrorMessage string
+		Expected     string
+	}{
+		{
+			TestName:     "already a member",
+			ErrorCode:    macie2.ErrorCodeClientError,
+			ErrorMessage: "The account is already a member",
+			Expected:     "the account is already a member of an administrator account, disassociate it from its current administrator account or import the existing member",
+		},
+		{
+			TestName:     "invalid email",
+			ErrorCode:    macie2.ErrorCodeClientError,
+			ErrorMessage: "Invalid Email address",
+			Expected:     "check that the email address is the email address of the root user of the account",
+		},
+		{
+			TestName:     "account suspended",
+			ErrorCode:    macie2.ErrorCodeClientError,
+			ErrorMessage: "The account is SUSPENDED",
+			Expected:     "the account is suspended, reactivate the account before inviting it again",
+		},
+		{
+			TestName:     "internal error",
+			ErrorCode:    macie2.ErrorCodeInternalError,
+			ErrorMessage: "Something went wrong",
+			Expected:     "the error is internal to Macie, retry the operation later",
+		},
+		{
+			TestName:     "unknown error",
+			ErrorCode:    macie2.ErrorCodeClientError,
+			ErrorMessage: "Something went wrong",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := UnprocessedAccountGuidance(testCase.ErrorCode, testCase.ErrorMessage); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
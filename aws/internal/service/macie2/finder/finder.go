@@ -1,6 +1,8 @@
 package finder
 
 import (
+	"strconv"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
 )
@@ -33,3 +35,78 @@ func MemberNotAssociated(conn *macie2.Macie2, accountID string) (*macie2.Member,
 
 	return result, err
 }
+
+// Members returns all the members of the administrator account. When onlyAssociated is true only
+// members with an active association are returned.
+func Members(conn *macie2.Macie2, onlyAssociated bool) ([]*macie2.Member, error) {
+	input := &macie2.ListMembersInput{
+		OnlyAssociated: aws.String(strconv.FormatBool(onlyAssociated)),
+	}
+	var result []*macie2.Member
+
+	err := conn.ListMembersPages(input, func(page *macie2.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, member := range page.Members {
+			if member == nil {
+				continue
+			}
+
+			result = append(result, member)
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// OrganizationAdminAccounts returns the delegated Amazon Macie administrator accounts of the organization.
+func OrganizationAdminAccounts(conn *macie2.Macie2) ([]*macie2.AdminAccount, error) {
+	input := &macie2.ListOrganizationAdminAccountsInput{}
+	var result []*macie2.AdminAccount
+
+	err := conn.ListOrganizationAdminAccountsPages(input, func(page *macie2.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, adminAccount := range page.AdminAccounts {
+			if adminAccount == nil {
+				continue
+			}
+
+			result = append(result, adminAccount)
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// FindingsFilters returns the findings filters of the account.
+func FindingsFilters(conn *macie2.Macie2) ([]*macie2.FindingsFilterListItem, error) {
+	input := &macie2.ListFindingsFiltersInput{}
+	var result []*macie2.FindingsFilterListItem
+
+	err := conn.ListFindingsFiltersPages(input, func(page *macie2.ListFindingsFiltersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, findingsFilter := range page.FindingsFilterListItems {
+			if findingsFilter == nil {
+				continue
+			}
+
+			result = append(result, findingsFilter)
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
@@ -0,0 +1,126 @@
+package macie2
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Commonly used findings filter criterion fields.
+const (
+	FindingCriterionFieldAccountID                  = "accountId"
+	FindingCriterionFieldArchived                   = "archived"
+	FindingCriterionFieldCategory                   = "category"
+	FindingCriterionFieldClassificationJobID        = "classificationDetails.jobId"
+	FindingCriterionFieldCount                      = "count"
+	FindingCriterionFieldCreatedAt                  = "createdAt"
+	FindingCriterionFieldID                         = "id"
+	FindingCriterionFieldRegion                     = "region"
+	FindingCriterionFieldS3BucketName               = "resourcesAffected.s3Bucket.name"
+	FindingCriterionFieldS3ObjectKey                = "resourcesAffected.s3Object.key"
+	FindingCriterionFieldSample                     = "sample"
+	FindingCriterionFieldSensitiveDataCategory      = "classificationDetails.result.sensitiveData.category"
+	FindingCriterionFieldSensitiveDataDetectionType = "classificationDetails.result.sensitiveData.detections.type"
+	FindingCriterionFieldSeverityDescription        = "severity.description"
+	FindingCriterionFieldSeverityScore              = "severity.score"
+	FindingCriterionFieldType                       = "type"
+	FindingCriterionFieldUpdatedAt                  = "updatedAt"
+)
+
+// FindingCriterionFields returns the fields that can be used in a findings filter criterion.
+// See https://docs.aws.amazon.com/macie/latest/user/findings-filter-fields.html.
+func FindingCriterionFields() []string {
+	return []string{
+		FindingCriterionFieldAccountID,
+		FindingCriterionFieldArchived,
+		FindingCriterionFieldCategory,
+		FindingCriterionFieldClassificationJobID,
+		"classificationDetails.originType",
+		"classificationDetails.result.customDataIdentifiers.detections.arn",
+		"classificationDetails.result.customDataIdentifiers.detections.name",
+		FindingCriterionFieldSensitiveDataCategory,
+		FindingCriterionFieldSensitiveDataDetectionType,
+		"classificationDetails.result.status.code",
+		FindingCriterionFieldCount,
+		FindingCriterionFieldCreatedAt,
+		FindingCriterionFieldID,
+		"partition",
+		"policyDetails.action.actionType",
+		"policyDetails.action.apiCallDetails.api",
+		"policyDetails.action.apiCallDetails.apiServiceName",
+		"policyDetails.actor.domainDetails.domainName",
+		"policyDetails.actor.ipAddressDetails.ipAddressV4",
+		"policyDetails.actor.ipAddressDetails.ipCity.name",
+		"policyDetails.actor.ipAddressDetails.ipCountry.name",
+		"policyDetails.actor.ipAddressDetails.ipOwner.asn",
+		"policyDetails.actor.ipAddressDetails.ipOwner.asnOrg",
+		"policyDetails.actor.ipAddressDetails.ipOwner.isp",
+		"policyDetails.actor.userIdentity.assumedRole.accessKeyId",
+		"policyDetails.actor.userIdentity.assumedRole.accountId",
+		"policyDetails.actor.userIdentity.assumedRole.arn",
+		"policyDetails.actor.userIdentity.assumedRole.principalId",
+		"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.accountId",
+		"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.arn",
+		"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.principalId",
+		"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.userName",
+		"policyDetails.actor.userIdentity.awsAccount.accountId",
+		"policyDetails.actor.userIdentity.awsAccount.principalId",
+		"policyDetails.actor.userIdentity.awsService.invokedBy",
+		"policyDetails.actor.userIdentity.federatedUser.accessKeyId",
+		"policyDetails.actor.userIdentity.federatedUser.accountId",
+		"policyDetails.actor.userIdentity.federatedUser.arn",
+		"policyDetails.actor.userIdentity.federatedUser.principalId",
+		"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.accountId",
+		"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.arn",
+		"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.principalId",
+		"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.userName",
+		"policyDetails.actor.userIdentity.iamUser.accountId",
+		"policyDetails.actor.userIdentity.iamUser.arn",
+		"policyDetails.actor.userIdentity.iamUser.principalId",
+		"policyDetails.actor.userIdentity.iamUser.userName",
+		"policyDetails.actor.userIdentity.root.accountId",
+		"policyDetails.actor.userIdentity.root.arn",
+		"policyDetails.actor.userIdentity.root.principalId",
+		"policyDetails.actor.userIdentity.type",
+		FindingCriterionFieldRegion,
+		"resourcesAffected.s3Bucket.allowsUnencryptedObjectUploads",
+		"resourcesAffected.s3Bucket.defaultServerSideEncryption.encryptionType",
+		"resourcesAffected.s3Bucket.defaultServerSideEncryption.kmsMasterKeyId",
+		FindingCriterionFieldS3BucketName,
+		"resourcesAffected.s3Bucket.owner.displayName",
+		"resourcesAffected.s3Bucket.publicAccess.effectivePermission",
+		"resourcesAffected.s3Bucket.tags.key",
+		"resourcesAffected.s3Bucket.tags.value",
+		"resourcesAffected.s3Object.extension",
+		FindingCriterionFieldS3ObjectKey,
+		"resourcesAffected.s3Object.path",
+		"resourcesAffected.s3Object.publicAccess",
+		"resourcesAffected.s3Object.serverSideEncryption.encryptionType",
+		"resourcesAffected.s3Object.serverSideEncryption.kmsMasterKeyId",
+		"resourcesAffected.s3Object.storageClass",
+		"resourcesAffected.s3Object.tags.key",
+		"resourcesAffected.s3Object.tags.value",
+		FindingCriterionFieldSample,
+		FindingCriterionFieldSeverityDescription,
+		FindingCriterionFieldSeverityScore,
+		FindingCriterionFieldType,
+		FindingCriterionFieldUpdatedAt,
+	}
+}
+
+// ValidateFindingCriterionField returns an error if the specified field is not a known findings filter criterion field.
+// Fields which only differ from a known field by case are reported with the correctly cased field name.
+func ValidateFindingCriterionField(field string) error {
+	for _, v := range FindingCriterionFields() {
+		if field == v {
+			return nil
+		}
+	}
+
+	for _, v := range FindingCriterionFields() {
+		if strings.EqualFold(field, v) {
+			return fmt.Errorf("unknown finding criterion field (%s), did you mean (%s)?", field, v)
+		}
+	}
+
+	return fmt.Errorf("unknown finding criterion field (%s)", field)
+}
@@ -0,0 +1,64 @@
+package macie2
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestValidateFindingCriterionField(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Field         string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:          "empty",
+			Field:         "",
+			ExpectedError: regexp.MustCompile(`unknown finding criterion field`),
+		},
+		{
+			Name:  "severity description",
+			Field: FindingCriterionFieldSeverityDescription,
+		},
+		{
+			Name:  "type",
+			Field: FindingCriterionFieldType,
+		},
+		{
+			Name:  "S3 bucket name",
+			Field: FindingCriterionFieldS3BucketName,
+		},
+		{
+			Name:  "nested field",
+			Field: "policyDetails.actor.userIdentity.iamUser.userName",
+		},
+		{
+			Name:          "case mismatch",
+			Field:         "Severity.Description",
+			ExpectedError: regexp.MustCompile(`did you mean \(severity\.description\)`),
+		},
+		{
+			Name:          "typo",
+			Field:         "severity.descripton",
+			ExpectedError: regexp.MustCompile(`unknown finding criterion field \(severity\.descripton\)$`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := ValidateFindingCriterionField(testCase.Field)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error matching %q, got none", testCase.ExpectedError)
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %s", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package macie2
+
+import (
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ValidateFindingPublishingFrequency validates a findings publishing frequency.
+// All resources exposing a `finding_publishing_frequency` argument must use it so validation stays consistent.
+var ValidateFindingPublishingFrequency schema.SchemaValidateFunc = validation.StringInSlice(macie2.FindingPublishingFrequency_Values(), false)
@@ -0,0 +1,32 @@
+package macie2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+)
+
+func TestValidateFindingPublishingFrequency(t *testing.T) {
+	for _, v := range macie2.FindingPublishingFrequency_Values() {
+		_, errors := ValidateFindingPublishingFrequency(v, "finding_publishing_frequency")
+
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid findings publishing frequency: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"fifteen_minutes",
+		"1_HOUR",
+		"ONE_DAY",
+	}
+
+	for _, v := range invalidValues {
+		_, errors := ValidateFindingPublishingFrequency(v, "finding_publishing_frequency")
+
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid findings publishing frequency", v)
+		}
+	}
+}
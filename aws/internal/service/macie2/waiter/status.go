@@ -1,9 +1,13 @@
 package waiter
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
 )
 
@@ -23,3 +27,46 @@ func MemberRelationshipStatus(conn *macie2.Macie2, adminAccountID string) resour
 		return adminAccount, aws.StringValue(adminAccount.RelationshipStatus), nil
 	}
 }
+
+// MemberState fetches the Member of the administrator account and its normalized relationship state
+func MemberState(ctx context.Context, conn *macie2.Macie2, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{Id: aws.String(accountID)})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, tfmacie2.MemberState(aws.StringValue(output.RelationshipStatus)), nil
+	}
+}
+
+// AccountFindingPublishingFrequency fetches the Macie session and its finding publishing frequency
+func AccountFindingPublishingFrequency(ctx context.Context, conn *macie2.Macie2) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetMacieSessionWithContext(ctx, &macie2.GetMacieSessionInput{})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.FindingPublishingFrequency), nil
+	}
+}
+
+// ClassificationJobStatus fetches the ClassificationJob and its status
+func ClassificationJobStatus(ctx context.Context, conn *macie2.Macie2, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeClassificationJobWithContext(ctx, &macie2.DescribeClassificationJobInput{JobId: aws.String(jobID)})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobStatus), nil
+	}
+}
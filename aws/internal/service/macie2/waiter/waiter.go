@@ -6,20 +6,36 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
 )
 
 const (
-	// Maximum amount of time to wait for the MemberRelationshipStatus to be Invited, Enabled, or Paused
-	MemberInvitedTimeout = 5 * time.Minute
+	// Interval between two member status checks while the member is being invited
+	MemberInvitedPollInterval = 10 * time.Second
+
+	// Initial delay before the first member status check
+	MemberInvitedDelay = 5 * time.Second
+
+	// Maximum amount of time to wait for a member deleted by the provider to be Removed, Resigned or deleted before it is created again
+	MemberRemovedTimeout = 2 * time.Minute
+
+	// Interval between two member or account status checks once the request has been accepted
+	StatusPollInterval = 5 * time.Second
+
+	// Interval between two classification job status checks
+	ClassificationJobCompletedPollInterval = 30 * time.Second
 )
 
-// MemberInvited waits for an AdminAccount to return Invited, Enabled and Paused
-func MemberInvited(ctx context.Context, conn *macie2.Macie2, adminAccountID string) (*macie2.Member, error) {
+// MemberInvited waits for an AdminAccount to return Invited, Enabled and Paused.
+// The timeout is the timeout of the resource operation that sent the invitation.
+func MemberInvited(ctx context.Context, conn *macie2.Macie2, adminAccountID string, timeout time.Duration) (*macie2.Member, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{macie2.RelationshipStatusCreated, macie2.RelationshipStatusEmailVerificationInProgress},
-		Target:  []string{macie2.RelationshipStatusInvited, macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused},
-		Refresh: MemberRelationshipStatus(conn, adminAccountID),
-		Timeout: MemberInvitedTimeout,
+		Pending:      []string{macie2.RelationshipStatusCreated, macie2.RelationshipStatusEmailVerificationInProgress},
+		Target:       []string{macie2.RelationshipStatusInvited, macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused},
+		Refresh:      MemberRelationshipStatus(conn, adminAccountID),
+		Timeout:      timeout,
+		Delay:        MemberInvitedDelay,
+		PollInterval: MemberInvitedPollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -30,3 +46,90 @@ func MemberInvited(ctx context.Context, conn *macie2.Macie2, adminAccountID stri
 
 	return nil, err
 }
+
+// MemberDisassociated waits for a Member to be neither associated nor invited, e.g. Removed, after it was disassociated.
+func MemberDisassociated(ctx context.Context, conn *macie2.Macie2, accountID string, timeout time.Duration) (*macie2.GetMemberOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{tfmacie2.MemberStateAssociated, tfmacie2.MemberStateInvited},
+		Target:       []string{tfmacie2.MemberStateNotAssociated},
+		Refresh:      MemberState(ctx, conn, accountID),
+		Timeout:      timeout,
+		PollInterval: StatusPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*macie2.GetMemberOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// MemberRemoved waits for a Member still associated with the administrator account, e.g. right after it was deleted,
+// to be neither associated nor invited, e.g. Removed or Resigned. A NotFoundError is returned when the Member no longer exists.
+func MemberRemoved(ctx context.Context, conn *macie2.Macie2, accountID string, timeout time.Duration) (*macie2.GetMemberOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{tfmacie2.MemberStateAssociated, tfmacie2.MemberStateInvited},
+		Target:         []string{tfmacie2.MemberStateNotAssociated},
+		Refresh:        MemberState(ctx, conn, accountID),
+		Timeout:        timeout,
+		PollInterval:   StatusPollInterval,
+		NotFoundChecks: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*macie2.GetMemberOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AccountFindingPublishingFrequencyUpdated waits for the Macie session to report the specified finding publishing frequency.
+// The timeout is the update timeout of the account.
+func AccountFindingPublishingFrequencyUpdated(ctx context.Context, conn *macie2.Macie2, frequency string, timeout time.Duration) (*macie2.GetMacieSessionOutput, error) {
+	var pending []string
+	for _, v := range macie2.FindingPublishingFrequency_Values() {
+		if v != frequency {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:                   pending,
+		Target:                    []string{frequency},
+		Refresh:                   AccountFindingPublishingFrequency(ctx, conn),
+		Timeout:                   timeout,
+		PollInterval:              StatusPollInterval,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*macie2.GetMacieSessionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClassificationJobCompleted waits for a one-time ClassificationJob run to return Complete or Cancelled.
+func ClassificationJobCompleted(ctx context.Context, conn *macie2.Macie2, jobID string, timeout time.Duration) (*macie2.DescribeClassificationJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{macie2.JobStatusRunning, macie2.JobStatusIdle, macie2.JobStatusPaused},
+		Target:       []string{macie2.JobStatusComplete, macie2.JobStatusCancelled},
+		Refresh:      ClassificationJobStatus(ctx, conn, jobID),
+		Timeout:      timeout,
+		PollInterval: ClassificationJobCompletedPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*macie2.DescribeClassificationJobOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -181,6 +181,13 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"disable_invitation_email_notifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["disable_invitation_email_notifications"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -235,6 +242,12 @@ func Provider() *schema.Provider {
 			"aws_db_instance":                                dataSourceAwsDbInstance(),
 			"aws_db_snapshot":                                dataSourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                            dataSourceAwsDbSubnetGroup(),
+			"aws_detective_administrator":                    dataSourceAwsDetectiveAdministrator(),
+			"aws_detective_graph":                            dataSourceAwsDetectiveGraph(),
+			"aws_detective_graphs":                           dataSourceAwsDetectiveGraphs(),
+			"aws_detective_invitations":                      dataSourceAwsDetectiveInvitations(),
+			"aws_detective_member":                           dataSourceAwsDetectiveMember(),
+			"aws_detective_member_graph":                     dataSourceAwsDetectiveMemberGraph(),
 			"aws_directory_service_directory":                dataSourceAwsDirectoryServiceDirectory(),
 			"aws_docdb_engine_version":                       dataSourceAwsDocdbEngineVersion(),
 			"aws_docdb_orderable_db_instance":                dataSourceAwsDocdbOrderableDbInstance(),
@@ -341,6 +354,12 @@ func Provider() *schema.Provider {
 			"aws_lex_bot":                                    dataSourceAwsLexBot(),
 			"aws_lex_intent":                                 dataSourceAwsLexIntent(),
 			"aws_lex_slot_type":                              dataSourceAwsLexSlotType(),
+			"aws_macie2_account":                             dataSourceAwsMacie2Account(),
+			"aws_macie2_classification_job":                  dataSourceAwsMacie2ClassificationJob(),
+			"aws_macie2_findings_filter":                     dataSourceAwsMacie2FindingsFilter(),
+			"aws_macie2_findings_filters":                    dataSourceAwsMacie2FindingsFilters(),
+			"aws_macie2_members":                             dataSourceAwsMacie2Members(),
+			"aws_macie2_organization_admin_account":          dataSourceAwsMacie2OrganizationAdminAccount(),
 			"aws_mq_broker":                                  dataSourceAwsMqBroker(),
 			"aws_msk_cluster":                                dataSourceAwsMskCluster(),
 			"aws_msk_configuration":                          dataSourceAwsMskConfiguration(),
@@ -872,11 +891,14 @@ func Provider() *schema.Provider {
 			"aws_load_balancer_listener_policy":                       resourceAwsLoadBalancerListenerPolicies(),
 			"aws_lb_ssl_negotiation_policy":                           resourceAwsLBSSLNegotiationPolicy(),
 			"aws_macie2_account":                                      resourceAwsMacie2Account(),
+			"aws_macie2_administrator_disassociation":                 resourceAwsMacie2AdministratorDisassociation(),
 			"aws_macie2_classification_job":                           resourceAwsMacie2ClassificationJob(),
 			"aws_macie2_custom_data_identifier":                       resourceAwsMacie2CustomDataIdentifier(),
 			"aws_macie2_findings_filter":                              resourceAwsMacie2FindingsFilter(),
 			"aws_macie2_invitation_accepter":                          resourceAwsMacie2InvitationAccepter(),
 			"aws_macie2_member":                                       resourceAwsMacie2Member(),
+			"aws_macie2_member_session":                               resourceAwsMacie2MemberSession(),
+			"aws_macie2_members_session":                              resourceAwsMacie2MembersSession(),
 			"aws_macie2_organization_admin_account":                   resourceAwsMacie2OrganizationAdminAccount(),
 			"aws_macie_member_account_association":                    resourceAwsMacieMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":                         resourceAwsMacieS3BucketAssociation(),
@@ -1272,6 +1294,10 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"disable_invitation_email_notifications": "Set this to true to not send email notifications\n" +
+			"with the membership invitations of Amazon Macie and Amazon Detective member accounts\n" +
+			"whose resource does not set whether to send them.",
 	}
 
 	endpointServiceNames = []string{
@@ -1455,7 +1481,10 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		terraformVersion:        terraformVersion,
+
+		DisableInvitationEmailNotifications: d.Get("disable_invitation_email_notifications").(bool),
+
+		terraformVersion: terraformVersion,
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
@@ -7,14 +7,21 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/detective"
-	"github.com/aws/aws-sdk-go/service/macie2"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+// Maximum number of accounts accepted by a single DeleteMembers call.
+const detectiveDeleteMembersBatchSize = 50
+
 func resourceAwsDetectiveGraph() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDetectiveGraphCreate,
@@ -22,34 +29,120 @@ func resourceAwsDetectiveGraph() *schema.Resource {
 		UpdateWithoutTimeout: resourceDetectiveGraphUpdate,
 		DeleteWithoutTimeout: resourceDetectiveGraphDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDetectiveGraphImport,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// An adopted graph was not created by Terraform, so it is kept when the resource is destroyed.
+			"adopted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The member accounts are the ones currently in the behavior graph, whatever their status,
+			// including the members that are not managed by Terraform.
+			"member_account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"graph_tags": {
 				Type: schema.TypeMap,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Optional: true,
+				Optional:         true,
+				ValidateDiagFunc: tfdetective.ValidateGraphTags,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
 	}
 }
 
+func resourceDetectiveGraphImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*AWSClient)
+
+	graphARN, err := tfdetective.GraphImportARN(d.Id(), client.partition, client.region, client.accountid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(graphARN)
+	// Arguments without a remote value are defaulted so that imported graphs never show a plan difference.
+	d.Set("adopt_existing", false)
+	d.Set("adopted", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDetectiveGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).detectiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+
+	if d.Get("adopt_existing").(bool) {
+		graph, err := finder.Graph(ctx, conn)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return diag.FromErr(fmt.Errorf("error listing Detective Graphs: %w", err))
+		}
+
+		// Only one behavior graph is allowed per account and Region.
+		if graph != nil {
+			graphARN := aws.StringValue(graph.Arn)
+
+			log.Printf("[INFO] Adopting existing Detective Graph (%s)", graphARN)
+
+			if tags := detectiveGraphCreateTags(d.Get("graph_tags").(map[string]interface{}), defaultTagsConfig); len(tags) > 0 {
+				tagInput := &detective.TagResourceInput{
+					ResourceArn: aws.String(graphARN),
+					Tags:        tags,
+				}
+
+				if _, err := conn.TagResourceWithContext(ctx, tagInput); err != nil {
+					return diag.FromErr(fmt.Errorf("error tagging Detective Graph (%s): %w", graphARN, err))
+				}
+			}
+
+			d.SetId(graphARN)
+			d.Set("adopted", true)
 
-	input := &detective.CreateGraphInput{}
+			return resourceDetectiveGraphRead(ctx, d, meta)
+		}
+	}
 
-	if kv, ok := d.GetOk("graph_tags"); ok {
-		input.Tags = expandStringMap(kv.(map[string]interface{}))
+	// The graph is tagged on creation so that it is never left without its tags.
+	input := &detective.CreateGraphInput{
+		Tags: detectiveGraphCreateTags(d.Get("graph_tags").(map[string]interface{}), defaultTagsConfig),
 	}
 
 	var err error
 	var res *detective.CreateGraphOutput
-	err = resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		res, err = conn.CreateGraphWithContext(ctx, input)
+
+		if tfdetective.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -62,25 +155,24 @@ func resourceDetectiveGraphCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating graph: %w", err))
+		return diag.FromErr(fmt.Errorf("error creating Detective Graph: %w", err))
 	}
 
 	d.SetId(*res.GraphArn)
+	d.Set("adopted", false)
 
 	return resourceDetectiveGraphRead(ctx, d, meta)
 }
 
 func resourceDetectiveGraphRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).detectiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
-	input := &detective.ListTagsForResourceInput{
-		ResourceArn: aws.String(d.Id()),
-	}
+	tags, err := detectiveGraphListTags(conn, d.Id(), ignoreTagsConfig, defaultTagsConfig)
 
-	resp, err := conn.ListTagsForResourceWithContext(ctx, input)
-
-	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
-		log.Printf("[WARN] Graph resource (%s) does not seem to exist, removing from state", d.Id())
+	if tfdetective.IsNotFoundError(err) {
+		log.Printf("[WARN] Detective Graph (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
@@ -89,8 +181,26 @@ func resourceDetectiveGraphRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(fmt.Errorf("error reading Detective Graph (%s): %w", d.Id(), err))
 	}
 
-	if err := d.Set("graph_tags", resp.Tags); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags for resource %s: %s", d.Id(), err))
+	// The graph ARN is the resource ID, set it independently of the tags response so tag-less graphs are fully populated.
+	d.Set("graph_arn", d.Id())
+
+	if parsedARN, err := arn.Parse(d.Id()); err == nil {
+		d.Set("region", parsedARN.Region)
+	}
+
+	if err := d.Set("graph_tags", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting graph_tags for Detective Graph (%s): %w", d.Id(), err))
+	}
+
+	// All the pages of members are listed once per read.
+	members, err := detectiveGraphMembers(ctx, conn, d.Id(), d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Detective Graph (%s) members: %w", d.Id(), err))
+	}
+
+	if err := d.Set("member_account_ids", detectiveGraphMemberAccountIDs(members)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting member_account_ids for Detective Graph (%s): %w", d.Id(), err))
 	}
 
 	return nil
@@ -98,80 +208,134 @@ func resourceDetectiveGraphRead(ctx context.Context, d *schema.ResourceData, met
 
 func resourceDetectiveGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).detectiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
-	// Retrieve current values
-	tagsInput := &detective.ListTagsForResourceInput{
-		ResourceArn: aws.String(d.Id()),
-	}
+	if d.HasChange("graph_tags") {
+		// The current tags are read so that tags changed outside of Terraform are also reconciled.
+		oldTags, err := keyvaluetags.DetectiveListTags(conn, d.Id())
 
-	respTags, errTags := conn.ListTagsForResourceWithContext(ctx, tagsInput)
+		if tfdetective.IsNotFoundError(err) {
+			log.Printf("[WARN] Detective Graph (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 
-	if tfawserr.ErrCodeEquals(errTags, detective.ErrCodeResourceNotFoundException) {
-		log.Printf("[WARN] Graph resource (%s) does not seem to exist, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Detective Graph (%s): %w", d.Id(), err))
+		}
 
-	if errTags != nil {
-		return diag.FromErr(fmt.Errorf("error reading Detective Graph (%s): %w", d.Id(), errTags))
-	}
+		oldTags = oldTags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+		newTags := keyvaluetags.New(detectiveGraphCreateTags(d.Get("graph_tags").(map[string]interface{}), defaultTagsConfig)).IgnoreConfig(ignoreTagsConfig)
+
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err := keyvaluetags.DetectiveUpdateTags(conn, d.Id(), oldTags, newTags)
+
+			if tfdetective.IsThrottlingError(err) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
 
-	// Delete current values
-	deleteInput := &detective.UntagResourceInput{
-		ResourceArn: aws.String(d.Id()),
+		if isResourceTimeoutError(err) {
+			err = keyvaluetags.DetectiveUpdateTags(conn, d.Id(), oldTags, newTags)
+		}
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Detective Graph (%s) tags: %w", d.Id(), err))
+		}
 	}
 
-	tagKeys := []*string{}
-	for tagKey := range respTags.Tags {
-		tagKeys = append(tagKeys, aws.String(tagKey))
+	return resourceDetectiveGraphRead(ctx, d, meta)
+}
+
+// detectiveGraphMemberAccountIDs returns the account IDs of the specified members.
+func detectiveGraphMemberAccountIDs(members []*detective.MemberDetail) []string {
+	accountIDs := make([]string, 0, len(members))
+
+	for _, member := range members {
+		accountIDs = append(accountIDs, aws.StringValue(member.AccountId))
 	}
 
-	deleteInput.TagKeys = tagKeys
+	return accountIDs
+}
 
-	_, errUntag := conn.UntagResourceWithContext(ctx, deleteInput)
+// detectiveGraphMembers returns the members of the behavior graph.
+// Listing the members is retried when throttled, as graphs with many members page through many results.
+func detectiveGraphMembers(ctx context.Context, conn *detective.Detective, graphARN string, timeout time.Duration) ([]*detective.MemberDetail, error) {
+	var members []*detective.MemberDetail
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		members, err = finder.Members(ctx, conn, graphARN)
+
+		if tfdetective.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-	if tfawserr.ErrCodeEquals(errUntag, detective.ErrCodeResourceNotFoundException) {
-		log.Printf("[WARN] Graph resource (%s) does not seem to exist, removing from state", d.Id())
-		d.SetId("")
 		return nil
-	}
+	})
 
-	if errUntag != nil {
-		return diag.FromErr(fmt.Errorf("error untagging Detective Graph (%s): %w", d.Id(), errUntag))
+	if isResourceTimeoutError(err) {
+		members, err = finder.Members(ctx, conn, graphARN)
 	}
 
-	// Tag with new values
-	input := &detective.TagResourceInput{
-		ResourceArn: aws.String(d.Id()),
-	}
+	return members, err
+}
 
-	if kv, ok := d.GetOk("graph_tags"); ok && d.HasChange("graph_tags") {
-		input.Tags = expandStringMap(kv.(map[string]interface{}))
-	}
+// detectiveGraphListTags returns the graph tags as they are set in state,
+// i.e. without the AWS and ignored tags and without the provider default tags that are not overridden.
+func detectiveGraphListTags(conn *detective.Detective, graphARN string, ignoreTagsConfig *keyvaluetags.IgnoreConfig, defaultTagsConfig *keyvaluetags.DefaultConfig) (keyvaluetags.KeyValueTags, error) {
+	tags, err := keyvaluetags.DetectiveListTags(conn, graphARN)
 
-	_, err := conn.TagResourceWithContext(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Detective graph (%s): %w", d.Id(), err))
+		return tags, err
 	}
 
-	return resourceDetectiveGraphRead(ctx, d, meta)
+	return tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).RemoveDefaultConfig(defaultTagsConfig), nil
 }
 
 func resourceDetectiveGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).detectiveconn
 
+	if d.Get("adopted").(bool) {
+		log.Printf("[WARN] Detective Graph (%s) was adopted, not created, by Terraform, removing from state without deleting it", d.Id())
+		return nil
+	}
+
+	// Settling the members, deleting the graph and waiting for its deletion all share the delete timeout.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+
+	// Deleting a graph while member invitations are still being processed fails intermittently.
+	if err := detectiveGraphSettleMembers(ctx, conn, d.Id(), time.Until(deadline)); err != nil {
+		return diag.FromErr(fmt.Errorf("error removing in-flight members of Detective Graph (%s): %w", d.Id(), err))
+	}
+
 	input := &detective.DeleteGraphInput{
 		GraphArn: aws.String(d.Id()),
 	}
 
-	err := resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
 		_, err := conn.DeleteGraphWithContext(ctx, input)
 
 		if err != nil {
-			if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-				tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			if tfdetective.IsNotFoundError(err) {
 				return nil
 			}
+
+			if tfdetective.IsThrottlingError(err) {
+				return resource.RetryableError(err)
+			}
+
 			return resource.NonRetryableError(err)
 		}
 
@@ -183,7 +347,85 @@ func resourceDetectiveGraphDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error delete Detective graph (%s): %w", d.Id(), err))
+		return diag.FromErr(fmt.Errorf("error deleting Detective Graph (%s): %w", d.Id(), err))
+	}
+
+	if _, err := waiter.GraphDeleted(ctx, conn, d.Id(), time.Until(deadline)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Detective Graph (%s) to be deleted: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// detectiveGraphCreateTags returns the graph tags merged with the provider default tags.
+func detectiveGraphCreateTags(graphTags map[string]interface{}, defaultTagsConfig *keyvaluetags.DefaultConfig) map[string]*string {
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(graphTags)).IgnoreAws()
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return aws.StringMap(tags.Map())
+}
+
+// detectiveGraphSettleMembers waits for the email verification of the behavior graph members to complete,
+// then removes the members whose invitation is still pending.
+func detectiveGraphSettleMembers(ctx context.Context, conn *detective.Detective, graphARN string, timeout time.Duration) error {
+	members, err := finder.Members(ctx, conn, graphARN)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var accountIDs []*string
+
+	for _, member := range members {
+		accountID := aws.StringValue(member.AccountId)
+		status := aws.StringValue(member.Status)
+
+		if status == detective.MemberStatusVerificationInProgress {
+			member, err = waiter.MemberVerified(ctx, conn, graphARN, accountID, timeout)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error waiting for Detective member (%s) of graph (%s) verification: %w", accountID, graphARN, err)
+			}
+
+			status = aws.StringValue(member.Status)
+		}
+
+		if status == detective.MemberStatusInvited || status == detective.MemberStatusVerificationInProgress {
+			accountIDs = append(accountIDs, aws.String(accountID))
+		}
+	}
+
+	for i := 0; i < len(accountIDs); i += detectiveDeleteMembersBatchSize {
+		j := i + detectiveDeleteMembersBatchSize
+		if j > len(accountIDs) {
+			j = len(accountIDs)
+		}
+
+		log.Printf("[DEBUG] Disassociating in-flight members of Detective Graph (%s): %s", graphARN, aws.StringValueSlice(accountIDs[i:j]))
+
+		_, err := conn.DeleteMembersWithContext(ctx, &detective.DeleteMembersInput{
+			AccountIds: accountIDs[i:j],
+			GraphArn:   aws.String(graphARN),
+		})
+
+		if tfdetective.IsNotFoundError(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,526 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func TestDetectiveGraphCreateTags(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		GraphTags         map[string]interface{}
+		DefaultTagsConfig *keyvaluetags.DefaultConfig
+		Expected          map[string]string
+	}{
+		{
+			Name:      "no tags",
+			GraphTags: map[string]interface{}{},
+			Expected:  nil,
+		},
+		{
+			Name:      "graph tags",
+			GraphTags: map[string]interface{}{"key1": "value1"},
+			Expected:  map[string]string{"key1": "value1"},
+		},
+		{
+			Name:      "default tags",
+			GraphTags: map[string]interface{}{},
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{"provider": "value"}),
+			},
+			Expected: map[string]string{"provider": "value"},
+		},
+		{
+			Name:      "graph tags override default tags",
+			GraphTags: map[string]interface{}{"key1": "value1", "provider": "resource"},
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{"provider": "value", "key2": "value2"}),
+			},
+			Expected: map[string]string{"key1": "value1", "key2": "value2", "provider": "resource"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := detectiveGraphCreateTags(testCase.GraphTags, testCase.DefaultTagsConfig)
+
+			if testCase.Expected == nil {
+				if got != nil {
+					t.Errorf("got %v, expected nil", aws.StringValueMap(got))
+				}
+
+				return
+			}
+
+			if !reflect.DeepEqual(aws.StringValueMap(got), testCase.Expected) {
+				t.Errorf("got %v, expected %v", aws.StringValueMap(got), testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDetectiveGraphListTags(t *testing.T) {
+	remoteTags := map[string]*string{
+		"aws:cloudformation:stack-name": aws.String("stack"),
+		"ignored":                       aws.String("value"),
+		"ignoredprefix:key":             aws.String("value"),
+		"key1":                          aws.String("value1"),
+		"provider":                      aws.String("value"),
+		"overridden":                    aws.String("resource"),
+	}
+
+	testCases := []struct {
+		Name              string
+		Error             error
+		IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+		DefaultTagsConfig *keyvaluetags.DefaultConfig
+		Expected          map[string]string
+		ExpectedNotFound  bool
+	}{
+		{
+			Name: "no configuration",
+			Expected: map[string]string{
+				"ignored":           "value",
+				"ignoredprefix:key": "value",
+				"key1":              "value1",
+				"provider":          "value",
+				"overridden":        "resource",
+			},
+		},
+		{
+			Name: "ignore tags",
+			IgnoreTagsConfig: &keyvaluetags.IgnoreConfig{
+				Keys:        keyvaluetags.New([]string{"ignored"}),
+				KeyPrefixes: keyvaluetags.New([]string{"ignoredprefix:"}),
+			},
+			Expected: map[string]string{
+				"key1":       "value1",
+				"provider":   "value",
+				"overridden": "resource",
+			},
+		},
+		{
+			Name: "default tags",
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{"provider": "value", "overridden": "value"}),
+			},
+			Expected: map[string]string{
+				"ignored":           "value",
+				"ignoredprefix:key": "value",
+				"key1":              "value1",
+				"overridden":        "resource",
+			},
+		},
+		{
+			Name: "ignore and default tags",
+			IgnoreTagsConfig: &keyvaluetags.IgnoreConfig{
+				Keys: keyvaluetags.New([]string{"ignored", "provider"}),
+			},
+			DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{"provider": "value", "overridden": "value"}),
+			},
+			Expected: map[string]string{
+				"ignoredprefix:key": "value",
+				"key1":              "value1",
+				"overridden":        "resource",
+			},
+		},
+		{
+			Name:             "graph not found",
+			Error:            awserr.New(detective.ErrCodeResourceNotFoundException, "The request refers to a nonexistent resource", nil),
+			ExpectedNotFound: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := detective.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if testCase.Error != nil {
+					r.Error = testCase.Error
+					return
+				}
+
+				r.Data.(*detective.ListTagsForResourceOutput).Tags = remoteTags
+			})
+
+			got, err := detectiveGraphListTags(conn, "arn:aws:detective:us-east-1:123456789012:graph:a", testCase.IgnoreTagsConfig, testCase.DefaultTagsConfig) //lintignore:AWSAT003,AWSAT005
+
+			if testCase.ExpectedNotFound {
+				if !tfdetective.IsNotFoundError(err) {
+					t.Fatalf("expected not found error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got.Map(), testCase.Expected) {
+				t.Errorf("got %v, expected %v", got.Map(), testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccAwsDetectiveGraph_basic(t *testing.T) {
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveGraphConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "graph_arn", "detective", regexp.MustCompile(`graph:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "region", testAccGetRegion()),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "member_account_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "adopted", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsDetectiveGraphImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveGraph_tags(t *testing.T) {
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveGraphConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					testAccCheckAwsDetectiveGraphRemoteTags(resourceName, map[string]string{"key1": "value1"}),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsDetectiveGraphConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphRemoteTags(resourceName, map[string]string{"key1": "value1updated", "key2": "value2"}),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.key2", "value2"),
+				),
+			},
+			{
+				// The state written after the tags update matches the state of a fresh read of the graph.
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsDetectiveGraphConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphRemoteTags(resourceName, map[string]string{"key2": "value2"}),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsDetectiveGraphConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphRemoteTags(resourceName, map[string]string{}),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveGraph_defaultTags(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactoriesInternal(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(
+					testAccAWSProviderConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccAwsDetectiveGraphConfigTags1("key1", "value1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.key1", "value1"),
+					testAccCheckAwsDetectiveGraphRemoteTags(resourceName, map[string]string{"key1": "value1", "providerkey1": "providervalue1"}),
+				),
+			},
+			{
+				Config: composeConfig(
+					testAccAWSProviderConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccAwsDetectiveGraphConfigTags1("providerkey1", "value1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "graph_tags.providerkey1", "value1"),
+					testAccCheckAwsDetectiveGraphRemoteTags(resourceName, map[string]string{"providerkey1": "value1"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveGraph_pendingMember(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_graph.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveGraphDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				// The member is invited outside of Terraform so that the graph is destroyed while its invitation is pending.
+				Config: testAccAwsDetectiveGraphConfigPendingMember(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					testAccCheckAwsDetectiveGraphInviteMember(resourceName, "data.aws_caller_identity.member", email),
+				),
+			},
+			{
+				// The member invited outside of Terraform is listed once the graph is read again.
+				Config: testAccAwsDetectiveGraphConfigPendingMember(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member_account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_account_ids.*", "data.aws_caller_identity.member", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveGraphExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource (%s) has empty ID", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		graphs, err := finder.Graphs(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		for _, graph := range graphs {
+			if aws.StringValue(graph.Arn) == rs.Primary.ID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Detective Graph (%s) not found", rs.Primary.ID)
+	}
+}
+
+// testAccCheckAwsDetectiveGraphRemoteTags verifies the tags of the graph as returned by the Detective API.
+func testAccCheckAwsDetectiveGraphRemoteTags(resourceName string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		output, err := conn.ListTagsForResource(&detective.ListTagsForResourceInput{
+			ResourceArn: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := keyvaluetags.New(output.Tags).IgnoreAws().Map(); !reflect.DeepEqual(got, expected) {
+			return fmt.Errorf("Detective Graph (%s) tags: got %v, expected %v", rs.Primary.ID, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsDetectiveGraphInviteMember(resourceName, dataSourceName, email string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", dataSourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		output, err := conn.CreateMembers(&detective.CreateMembersInput{
+			Accounts: []*detective.Account{
+				{
+					AccountId:    aws.String(ds.Primary.Attributes["account_id"]),
+					EmailAddress: aws.String(email),
+				},
+			},
+			DisableEmailNotification: aws.Bool(true),
+			GraphArn:                 aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.UnprocessedAccounts) != 0 {
+			return fmt.Errorf("error inviting Detective member: %s", aws.StringValue(output.UnprocessedAccounts[0].Reason))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsDetectiveGraphDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_graph" {
+			continue
+		}
+
+		graphs, err := finder.Graphs(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		for _, graph := range graphs {
+			if aws.StringValue(graph.Arn) == rs.Primary.ID {
+				return fmt.Errorf("Detective Graph (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsDetectiveGraphConfigBasic() string {
+	return `
+resource "aws_detective_graph" "test" {}
+`
+}
+
+func testAccAwsDetectiveGraphConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  graph_tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAwsDetectiveGraphConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  graph_tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAwsDetectiveGraphConfigPendingMember() string {
+	return testAccAlternateAccountProviderConfig() + `
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "test" {}
+`
+}
+
+// testAccAwsDetectiveGraphImportStateIdFunc returns the short graph ID, the suffix after "graph:" in the graph ARN.
+func testAccAwsDetectiveGraphImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, ":graph:", 2)
+		if len(parts) != 2 {
+			return "", fmt.Errorf("unexpected format of graph ARN (%s)", rs.Primary.ID)
+		}
+
+		return parts[1], nil
+	}
+}
@@ -3,13 +3,21 @@ package aws
 import (
 	"context"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsDetectiveInvitationAccept() *schema.Resource {
@@ -19,48 +27,133 @@ func resourceAwsDetectiveInvitationAccept() *schema.Resource {
 		UpdateWithoutTimeout: resourceDetectiveInvitationAcceptUpdate,
 		DeleteWithoutTimeout: resourceDetectiveInvitationAcceptDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDetectiveInvitationAcceptImport,
 		},
+		CustomizeDiff: resourceDetectiveInvitationAcceptCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"graph_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"graph_arn", "graph_arns"},
+			},
+			"graph_arns": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"graph_arn", "graph_arns"},
 			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"statuses": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
 		},
 	}
 }
 
-func resourceDetectiveInvitationAcceptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).detectiveconn
+func resourceDetectiveInvitationAcceptCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	region := meta.(*AWSClient).region
 
-	input := &detective.AcceptInvitationInput{
-		GraphArn: aws.String(d.Get("graph_arn").(string)),
+	if diff.NewValueKnown("graph_arn") {
+		if err := tfdetective.ValidateGraphARNRegion(diff.Get("graph_arn").(string), region); err != nil {
+			return err
+		}
 	}
 
-	var err error
-	err = resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
-		_, err = conn.AcceptInvitationWithContext(ctx, input)
-		if err != nil {
-			return resource.NonRetryableError(err)
+	if diff.NewValueKnown("graph_arns") {
+		for _, v := range diff.Get("graph_arns").(*schema.Set).List() {
+			if err := tfdetective.ValidateGraphARNRegion(v.(string), region); err != nil {
+				return err
+			}
 		}
+	}
 
-		return nil
-	})
+	return nil
+}
 
-	if isResourceTimeoutError(err) {
-		_, err = conn.AcceptInvitationWithContext(ctx, input)
+func resourceDetectiveInvitationAcceptImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).detectiveconn
+
+	ids, err := tfdetective.InvitationAcceptParseID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The graph ARN of a short graph ID cannot be built from the provider account, which is the member account,
+	// so it is looked up in the invitations of the account. The invitations include the accepted ones, so that
+	// a membership accepted outside of Terraform can be imported and its status read.
+	invitations, err := detectiveInvitationsByGraphARN(ctx, conn, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing Detective invitations: %w", err)
 	}
 
+	graphARNs, err := detectiveInvitationAcceptImportGraphARNs(ids, invitations)
+
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error accepting invitation: %w", err))
+		return nil, err
 	}
 
-	d.SetId(*input.GraphArn)
+	d.SetId(tfdetective.InvitationAcceptCreateID(graphARNs))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// detectiveInvitationAcceptImportGraphARNs returns the graph ARNs of the imported IDs, which are either
+// graph ARNs or short graph IDs, the suffix after "graph:" in the graph ARN of one of the invitations.
+func detectiveInvitationAcceptImportGraphARNs(ids []string, invitations map[string]*detective.MemberDetail) ([]string, error) {
+	graphARNs := make([]string, len(ids))
+
+	for i, id := range ids {
+		if arn.IsARN(id) {
+			graphARNs[i] = id
+			continue
+		}
+
+		for graphARN := range invitations {
+			if strings.HasSuffix(graphARN, ":graph:"+id) {
+				graphARNs[i] = graphARN
+				break
+			}
+		}
+
+		if graphARNs[i] == "" {
+			return nil, fmt.Errorf("no Detective invitation found for graph ID (%s), %s", id, detectiveInvitationGraphARNsMessage(invitations))
+		}
+	}
+
+	return graphARNs, nil
+}
+
+func resourceDetectiveInvitationAcceptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	var acceptedGraphARNs []string
+
+	for _, graphARN := range detectiveInvitationAcceptGraphARNs(d) {
+		if err := detectiveInvitationAccept(ctx, conn, graphARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The ID is set after each accepted invitation, so that the accepted invitations are kept in state
+		// and disassociated on destroy when a later invitation cannot be accepted.
+		acceptedGraphARNs = append(acceptedGraphARNs, graphARN)
+		d.SetId(tfdetective.InvitationAcceptCreateID(acceptedGraphARNs))
+	}
 
 	return resourceDetectiveInvitationAcceptRead(ctx, d, meta)
 }
@@ -68,59 +161,311 @@ func resourceDetectiveInvitationAcceptCreate(ctx context.Context, d *schema.Reso
 func resourceDetectiveInvitationAcceptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).detectiveconn
 
-	input := &detective.ListInvitationsInput{}
+	graphARNs, err := tfdetective.InvitationAcceptParseID(d.Id())
 
-	resp, err := conn.ListInvitationsWithContext(ctx, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The invitations are listed once for all the graphs to limit the number of ListInvitations calls.
+	invitations, err := detectiveInvitationsByGraphARN(ctx, conn, d.Timeout(schema.TimeoutRead))
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading Detective member invitation (%s): %w", d.Id(), err))
+		return diag.FromErr(fmt.Errorf("error reading Detective Invitation Accept (%s): %w", d.Id(), err))
 	}
 
-	var invitationDetails *detective.MemberDetail = nil
-	for _, invitation := range resp.Invitations {
-		if *invitation.GraphArn == d.Id() {
-			invitationDetails = invitation
-			break
+	var acceptedGraphARNs []string
+	statuses := make(map[string]string, len(graphARNs))
+
+	for _, graphARN := range graphARNs {
+		invitation, ok := invitations[graphARN]
+
+		if !ok {
+			if d.IsNewResource() {
+				return diag.FromErr(fmt.Errorf("error reading Detective Invitation Accept (%s): invitation for graph (%s) not found", d.Id(), graphARN))
+			}
+
+			log.Printf("[WARN] Detective invitation for graph (%s) not found", graphARN)
+			continue
 		}
+
+		acceptedGraphARNs = append(acceptedGraphARNs, graphARN)
+		statuses[graphARN] = aws.StringValue(invitation.Status)
 	}
 
-	if invitationDetails == nil {
+	if len(acceptedGraphARNs) == 0 {
+		log.Printf("[WARN] Detective Invitation Accept (%s) not found, removing from state", d.Id())
 		d.SetId("")
-		return diag.FromErr(fmt.Errorf("No invitation was found for graph (%s): %w", d.Id()))
+		return nil
 	}
 
-	d.Set("status", invitationDetails.Status)
-	d.Set("graph_arn", invitationDetails.GraphArn)
+	// The ID only keeps the graphs that are still listed, so that the missing ones are accepted again on the next update.
+	d.SetId(tfdetective.InvitationAcceptCreateID(acceptedGraphARNs))
+
+	if err := d.Set("statuses", statuses); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting statuses: %w", err))
+	}
+
+	// An invitation accept for several graphs, or imported with a composite ID, is configured with graph_arns.
+	if _, ok := d.GetOk("graph_arns"); ok || len(graphARNs) > 1 {
+		if err := d.Set("graph_arns", acceptedGraphARNs); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting graph_arns: %w", err))
+		}
+
+		return nil
+	}
+
+	d.Set("graph_arn", acceptedGraphARNs[0])
+	d.Set("status", statuses[acceptedGraphARNs[0]])
+
 	return nil
 }
 
+func resourceDetectiveInvitationAcceptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	oldGraphARNs, err := tfdetective.InvitationAcceptParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	newGraphARNs := detectiveInvitationAcceptGraphARNs(d)
+
+	o := schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(oldGraphARNs)))
+	n := schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(newGraphARNs)))
+
+	// The ID is updated after each change, so that it always lists the graphs the account is a member of.
+	// The new invitations are accepted first, so that the ID is never emptied while the graphs are replaced.
+	current := schema.NewSet(schema.HashString, o.List())
+
+	for _, graphARN := range n.Difference(o).List() {
+		if err := detectiveInvitationAccept(ctx, conn, graphARN.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		current.Add(graphARN)
+		d.SetId(tfdetective.InvitationAcceptCreateID(aws.StringValueSlice(expandStringSet(current))))
+	}
+
+	for _, graphARN := range o.Difference(n).List() {
+		if err := detectiveInvitationDisassociate(ctx, conn, graphARN.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		current.Remove(graphARN)
+		d.SetId(tfdetective.InvitationAcceptCreateID(aws.StringValueSlice(expandStringSet(current))))
+	}
+
+	return resourceDetectiveInvitationAcceptRead(ctx, d, meta)
+}
+
 func resourceDetectiveInvitationAcceptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).detectiveconn
 
+	graphARNs, err := tfdetective.InvitationAcceptParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, graphARN := range graphARNs {
+		if err := detectiveInvitationDisassociate(ctx, conn, graphARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// detectiveInvitationAcceptGraphARNs returns the configured graph ARNs, from either graph_arn or graph_arns.
+func detectiveInvitationAcceptGraphARNs(d *schema.ResourceData) []string {
+	if v, ok := d.GetOk("graph_arns"); ok {
+		return aws.StringValueSlice(expandStringSet(v.(*schema.Set)))
+	}
+
+	return []string{d.Get("graph_arn").(string)}
+}
+
+// detectiveInvitationAccept waits for the invitation to the specified graph to be listed, then accepts it.
+func detectiveInvitationAccept(ctx context.Context, conn *detective.Detective, graphARN string, timeout time.Duration) error {
+	var invitation *detective.MemberDetail
+
+	// The invitation may not be visible to the member account yet when it was
+	// sent in the same apply, so wait for it to be listed before accepting it.
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		invitation, err = finder.InvitationByGraphARN(ctx, conn, graphARN)
+
+		// Several invitation accepts created in the same apply list the invitations concurrently.
+		if tfresource.NotFound(err) || tfdetective.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		invitation, err = finder.InvitationByGraphARN(ctx, conn, graphARN)
+	}
+
+	if tfresource.NotFound(err) {
+		return detectiveInvitationNotFoundError(ctx, conn, graphARN, timeout, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Detective invitation for graph (%s): %w", graphARN, err)
+	}
+
+	// An account that is already a member, e.g. when the invitation was accepted outside of Terraform,
+	// cannot accept the invitation again, so the membership is adopted as is. A member can be disabled
+	// as soon as it is accepted, when its data volume is too high for the graph.
+	if status := aws.StringValue(invitation.Status); status == detective.MemberStatusEnabled || status == detective.MemberStatusAcceptedButDisabled {
+		log.Printf("[DEBUG] Detective invitation for graph (%s) is already accepted, skipping invitation accept", graphARN)
+		return nil
+	}
+
+	input := &detective.AcceptInvitationInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err = conn.AcceptInvitationWithContext(ctx, input)
+
+		// The invitation may still be settling after it was sent.
+		if tfdetective.IsInvitationSettlingError(err) || tfdetective.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.AcceptInvitationWithContext(ctx, input)
+	}
+
+	if tfdetective.IsNotFoundError(err) {
+		return detectiveInvitationNotFoundError(ctx, conn, graphARN, timeout, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error accepting Detective invitation for graph (%s): %w", graphARN, err)
+	}
+
+	if _, err := waiter.InvitationAccepted(ctx, conn, graphARN, timeout); err != nil {
+		return fmt.Errorf("error waiting for Detective invitation for graph (%s) to be accepted: %w", graphARN, err)
+	}
+
+	return nil
+}
+
+// detectiveInvitationNotFoundError returns an error for a missing invitation that lists the graphs
+// the member account does have invitations for, as a mismatched graph ARN is the most common cause.
+func detectiveInvitationNotFoundError(ctx context.Context, conn *detective.Detective, graphARN string, timeout time.Duration, err error) error {
+	invitations, listErr := detectiveInvitationsByGraphARN(ctx, conn, timeout)
+
+	if listErr != nil {
+		log.Printf("[WARN] Unable to list Detective invitations: %s", listErr)
+
+		return fmt.Errorf("error accepting Detective invitation for graph (%s): no invitation found: %w", graphARN, err)
+	}
+
+	return fmt.Errorf("error accepting Detective invitation for graph (%s): no invitation found, %s: %w", graphARN, detectiveInvitationGraphARNsMessage(invitations), err)
+}
+
+// detectiveInvitationGraphARNsMessage describes the graphs of the specified invitations, sorted by ARN.
+func detectiveInvitationGraphARNsMessage(invitations map[string]*detective.MemberDetail) string {
+	if len(invitations) == 0 {
+		return "the account has no pending invitations"
+	}
+
+	graphARNs := make([]string, 0, len(invitations))
+
+	for graphARN, invitation := range invitations {
+		graphARNs = append(graphARNs, fmt.Sprintf("%s (%s)", graphARN, aws.StringValue(invitation.Status)))
+	}
+
+	sort.Strings(graphARNs)
+
+	return fmt.Sprintf("the account has invitations for graphs: %s", strings.Join(graphARNs, ", "))
+}
+
+// detectiveInvitationDisassociate removes the member account from the specified graph, retrying when throttled.
+func detectiveInvitationDisassociate(ctx context.Context, conn *detective.Detective, graphARN string, timeout time.Duration) error {
 	input := &detective.DisassociateMembershipInput{
-		GraphArn: aws.String(d.Id()),
+		GraphArn: aws.String(graphARN),
+	}
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := conn.DisassociateMembershipWithContext(ctx, input)
+
+		if tfdetective.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DisassociateMembershipWithContext(ctx, input)
+	}
+
+	if tfdetective.IsNotFoundError(err) {
+		return nil
 	}
 
-	_, err := conn.DisassociateMembershipWithContext(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting graph membership for (%s): %w", d.Id(), err))
+		return fmt.Errorf("error disassociating from Detective Graph (%s): %w", graphARN, err)
 	}
 
 	return nil
 }
 
-func resourceDetectiveInvitationAcceptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diagnostics diag.Diagnostics
+// detectiveInvitationsByGraphARN returns the behavior graph invitations of the calling member account, keyed by graph ARN.
+// Listing the invitations is retried when throttled, as accounts invited to many graphs page through many results.
+func detectiveInvitationsByGraphARN(ctx context.Context, conn *detective.Detective, timeout time.Duration) (map[string]*detective.MemberDetail, error) {
+	var invitations []*detective.MemberDetail
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		invitations, err = finder.Invitations(ctx, conn)
+
+		if tfdetective.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
 
-	diagnostics = resourceDetectiveInvitationAcceptDelete(ctx, d, meta)
-	if diagnostics != nil {
-		return diagnostics
+	if isResourceTimeoutError(err) {
+		invitations, err = finder.Invitations(ctx, conn)
 	}
 
-	diagnostics = resourceDetectiveInvitationAcceptCreate(ctx, d, meta)
-	if diagnostics != nil {
-		return diagnostics
+	if err != nil {
+		return nil, err
 	}
 
-	return resourceDetectiveInvitationAcceptRead(ctx, d, meta)
+	result := make(map[string]*detective.MemberDetail, len(invitations))
+
+	for _, invitation := range invitations {
+		result[aws.StringValue(invitation.GraphArn)] = invitation
+	}
+
+	return result, nil
 }
@@ -0,0 +1,459 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	EnvVarDetectiveAlternateEmail             = "AWS_DETECTIVE_ALTERNATE_ACCOUNT_EMAIL"
+	EnvVarDetectiveAlternateEmailMessageError = "Environment variable AWS_DETECTIVE_ALTERNATE_ACCOUNT_EMAIL is not set. " +
+		"To properly test inviting Detective member account must be provided."
+)
+
+func TestDetectiveInvitationAcceptImportGraphARNs(t *testing.T) {
+	invitations := map[string]*detective.MemberDetail{
+		"arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789abcdef0123456789": {Status: aws.String(detective.MemberStatusEnabled)}, //lintignore:AWSAT003,AWSAT005
+		"arn:aws:detective:us-east-1:210987654321:graph:0123456789abcdef0123456789abcdef": {Status: aws.String(detective.MemberStatusInvited)}, //lintignore:AWSAT003,AWSAT005
+	}
+
+	testCases := []struct {
+		Name              string
+		IDs               []string
+		ExpectedGraphARNs []string
+		ExpectedError     bool
+	}{
+		{
+			Name:              "graph ARN",
+			IDs:               []string{"arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789abcdef0123456789"}, //lintignore:AWSAT003,AWSAT005
+			ExpectedGraphARNs: []string{"arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789abcdef0123456789"}, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:              "graph ID",
+			IDs:               []string{"abcdef0123456789abcdef0123456789"},
+			ExpectedGraphARNs: []string{"arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789abcdef0123456789"}, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "graph ARN and graph ID",
+			IDs:  []string{"arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789abcdef0123456789", "0123456789abcdef0123456789abcdef"}, //lintignore:AWSAT003,AWSAT005
+			ExpectedGraphARNs: []string{
+				"arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789abcdef0123456789", //lintignore:AWSAT003,AWSAT005
+				"arn:aws:detective:us-east-1:210987654321:graph:0123456789abcdef0123456789abcdef", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		{
+			Name:          "unknown graph ID",
+			IDs:           []string{"ffffffffffffffffffffffffffffffff"},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := detectiveInvitationAcceptImportGraphARNs(testCase.IDs, invitations)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedGraphARNs) {
+				t.Errorf("got %v, expected %v", got, testCase.ExpectedGraphARNs)
+			}
+		})
+	}
+}
+
+func TestDetectiveInvitationGraphARNsMessage(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Invitations map[string]*detective.MemberDetail
+		Expected    string
+	}{
+		{
+			Name:     "no invitations",
+			Expected: "the account has no pending invitations",
+		},
+		{
+			Name: "invitations",
+			Invitations: map[string]*detective.MemberDetail{
+				"arn:aws:detective:us-west-2:123456789012:graph:b": {Status: aws.String(detective.MemberStatusInvited)},
+				"arn:aws:detective:us-west-2:123456789012:graph:a": {Status: aws.String(detective.MemberStatusEnabled)},
+			},
+			Expected: "the account has invitations for graphs: arn:aws:detective:us-west-2:123456789012:graph:a (ENABLED), arn:aws:detective:us-west-2:123456789012:graph:b (INVITED)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := detectiveInvitationGraphARNsMessage(testCase.Invitations); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDetectiveInvitationAccept(t *testing.T) {
+	graphARN := "arn:aws:detective:us-west-2:123456789012:graph:a" //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		Name                   string
+		Status                 string
+		ExpectedAcceptedCalled bool
+	}{
+		{
+			Name:   "already enabled",
+			Status: detective.MemberStatusEnabled,
+		},
+		{
+			Name:   "already accepted but disabled",
+			Status: detective.MemberStatusAcceptedButDisabled,
+		},
+		{
+			Name:                   "invited",
+			Status:                 detective.MemberStatusInvited,
+			ExpectedAcceptedCalled: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := detective.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var acceptCalled bool
+			status := testCase.Status
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *detective.ListInvitationsOutput:
+					data.Invitations = []*detective.MemberDetail{
+						{GraphArn: aws.String(graphARN), Status: aws.String(status)},
+					}
+				case *detective.AcceptInvitationOutput:
+					acceptCalled = true
+					status = detective.MemberStatusEnabled
+				}
+			})
+
+			if err := detectiveInvitationAccept(context.Background(), conn, graphARN, time.Minute); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if acceptCalled != testCase.ExpectedAcceptedCalled {
+				t.Errorf("AcceptInvitation called %t, expected %t", acceptCalled, testCase.ExpectedAcceptedCalled)
+			}
+		})
+	}
+}
+
+func testAccAwsDetectiveInvitationAccept_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accept.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAcceptDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveInvitationAcceptConfigBasic(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveInvitationAcceptExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.admin", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusEnabled),
+				),
+			},
+			{
+				Config:            testAccAwsDetectiveInvitationAcceptConfigBasic(email),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:            testAccAwsDetectiveInvitationAcceptConfigBasic(email),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsDetectiveGraphImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveInvitationAccept_importAccepted(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accept.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAcceptDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				// The membership is accepted by another resource, so that it is accepted outside of the imported resource.
+				Config: testAccAwsDetectiveInvitationAcceptConfigOutOfBand(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_detective_invitation_accept.out_of_band", "status", detective.MemberStatusEnabled),
+				),
+			},
+			{
+				Config:            testAccAwsDetectiveInvitationAcceptConfigImportAccepted(email),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsDetectiveInvitationAcceptImportStateIdFunc("aws_detective_graph.admin"),
+				ImportStateCheck:  testAccCheckAwsDetectiveInvitationAcceptImportedStatus(detective.MemberStatusEnabled),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveInvitationAccept_GraphArnUnknown(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accept.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAcceptDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				// The graph ARN is only known after the graph and invitation are created in the same apply.
+				Config: testAccAwsDetectiveInvitationAcceptConfigGraphArnUnknown(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveInvitationAcceptExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "graph_arn", "detective", regexp.MustCompile(`graph:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveInvitationAccept_GraphArns(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accept.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAcceptDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveInvitationAcceptConfigGraphArns(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveInvitationAcceptExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "graph_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "graph_arns.*", "aws_detective_graph.admin", "id"),
+					resource.TestCheckResourceAttr(resourceName, "statuses.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveInvitationAccept_regionMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAcceptDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsDetectiveInvitationAcceptConfigRegionMismatch(testAccGetAlternateRegion()),
+				ExpectError: regexp.MustCompile(`not in the provider Region`),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveInvitationAcceptExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource (%s) has empty ID", resourceName)
+		}
+
+		return nil
+	}
+}
+
+// testAccAwsDetectiveInvitationAcceptImportStateIdFunc returns the ARN of the graph, which is the ID of the invitation accept.
+func testAccAwsDetectiveInvitationAcceptImportStateIdFunc(graphResourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[graphResourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", graphResourceName)
+		}
+
+		return rs.Primary.ID, nil
+	}
+}
+
+func testAccCheckAwsDetectiveInvitationAcceptImportedStatus(status string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported state, got %d", len(states))
+		}
+
+		if got := states[0].Attributes["status"]; got != status {
+			return fmt.Errorf("imported Detective Invitation Accept (%s) status: got %q, expected %q", states[0].ID, got, status)
+		}
+
+		if got := states[0].Attributes["graph_arn"]; got != states[0].ID {
+			return fmt.Errorf("imported Detective Invitation Accept (%s) graph_arn: got %q, expected %q", states[0].ID, got, states[0].ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsDetectiveInvitationAcceptDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_invitation_request" {
+			continue
+		}
+
+		graphARN := rs.Primary.Attributes["graph_arn"]
+		accountID := rs.Primary.Attributes["account"]
+
+		_, err := finder.MemberByGraphARNAndAccountID(context.Background(), conn, graphARN, accountID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Detective member %q still exists in graph %q", accountID, graphARN)
+	}
+
+	return nil
+}
+
+func testAccAwsDetectiveInvitationAcceptConfigBase(email string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "admin" {}
+
+resource "aws_detective_invitation_request" "member" {
+  graph_arn                  = aws_detective_graph.admin.id
+  account                    = data.aws_caller_identity.member.account_id
+  email                      = %[1]q
+  disable_email_notification = true
+}
+`, email)
+}
+
+func testAccAwsDetectiveInvitationAcceptConfigBasic(email string) string {
+	return composeConfig(testAccAwsDetectiveInvitationAcceptConfigBase(email), `
+resource "aws_detective_invitation_accept" "member" {
+  provider   = "awsalternate"
+  graph_arn  = aws_detective_graph.admin.id
+  depends_on = [aws_detective_invitation_request.member]
+}
+`)
+}
+
+func testAccAwsDetectiveInvitationAcceptConfigGraphArnUnknown(email string) string {
+	return composeConfig(testAccAwsDetectiveInvitationAcceptConfigBase(email), `
+resource "aws_detective_invitation_accept" "member" {
+  provider  = "awsalternate"
+  graph_arn = aws_detective_invitation_request.member.graph_arn
+}
+`)
+}
+
+func testAccAwsDetectiveInvitationAcceptConfigGraphArns(email string) string {
+	return composeConfig(testAccAwsDetectiveInvitationAcceptConfigBase(email), `
+resource "aws_detective_invitation_accept" "member" {
+  provider   = "awsalternate"
+  graph_arns = [aws_detective_graph.admin.id]
+  depends_on = [aws_detective_invitation_request.member]
+}
+`)
+}
+
+func testAccAwsDetectiveInvitationAcceptConfigOutOfBand(email string) string {
+	return composeConfig(testAccAwsDetectiveInvitationAcceptConfigBase(email), `
+resource "aws_detective_invitation_accept" "out_of_band" {
+  provider   = "awsalternate"
+  graph_arn  = aws_detective_graph.admin.id
+  depends_on = [aws_detective_invitation_request.member]
+}
+`)
+}
+
+func testAccAwsDetectiveInvitationAcceptConfigImportAccepted(email string) string {
+	return composeConfig(testAccAwsDetectiveInvitationAcceptConfigOutOfBand(email), `
+resource "aws_detective_invitation_accept" "member" {
+  provider  = "awsalternate"
+  graph_arn = aws_detective_graph.admin.id
+}
+`)
+}
+
+func testAccAwsDetectiveInvitationAcceptConfigRegionMismatch(region string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_detective_invitation_accept" "member" {
+  graph_arn = "arn:${data.aws_partition.current.partition}:detective:%[1]s:111111111111:graph:abcdef0123456789abcdef0123456789"
+}
+`, region)
+}
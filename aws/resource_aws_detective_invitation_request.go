@@ -2,8 +2,10 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,19 +15,34 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const IdSeparator = "/"
 
+// detectiveGraphMemberAccountsQuota is the default maximum number of member accounts of a behavior graph.
+const detectiveGraphMemberAccountsQuota = 1200
+
+// detectiveInvitationRequestMemberRefreshedTimeout is how long the members of the graph are listed
+// when GetMembers reports no member of an existing resource, so that refreshing a deleted member does not stall plans.
+const detectiveInvitationRequestMemberRefreshedTimeout = 5 * time.Second
+
+// Detective member accounts have no ARN and cannot be tagged, only behavior graphs support tags.
 func resourceAwsDetectiveInvitationRequest() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDetectiveInvitationRequestCreate,
 		ReadWithoutTimeout:   resourceDetectiveInvitationRequestRead,
 		UpdateWithoutTimeout: resourceDetectiveInvitationRequestUpdate,
 		DeleteWithoutTimeout: resourceDetectiveInvitationRequestDelete,
+		// Imported invitation requests have no invitation_email_notification_disabled value,
+		// since it cannot be read back from the API, until the member is re-invited by an update.
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceDetectiveInvitationRequestCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"graph_arn": {
@@ -37,32 +54,99 @@ func resourceAwsDetectiveInvitationRequest() *schema.Resource {
 				Required: true,
 			},
 			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMemberAccountEmail,
+			},
+			"administrator_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Computed: true,
 			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disabled_reason_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"message": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			// No default, so that the provider disable_invitation_email_notifications setting applies when it is not set.
 			"disable_email_notification": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  false,
+			},
+			// The API does not report whether the invitation suppressed the email notification,
+			// so the value sent with the invitation is recorded once and preserved by Read.
+			"invitation_email_notification_disabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			// How long the members of the graph are listed when GetMembers reports no member of a new resource,
+			// before the member is considered deleted.
+			Read:   schema.DefaultTimeout(30 * time.Second),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+	}
+}
+
+func resourceDetectiveInvitationRequestCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.NewValueKnown("graph_arn") {
+		if err := tfdetective.ValidateGraphARNRegion(diff.Get("graph_arn").(string), meta.(*AWSClient).region); err != nil {
+			return err
+		}
+	}
+
+	// The administrator account of a behavior graph cannot invite itself.
+	if accountID := diff.Get("account").(string); diff.NewValueKnown("account") && accountID == meta.(*AWSClient).accountid {
+		return fmt.Errorf("account (%s) is the behavior graph administrator account and cannot be invited as a member", accountID)
+	}
+
+	// Any update re-invites the member, which records the new disable_email_notification value.
+	if diff.Id() != "" && diff.HasChanges("graph_arn", "account", "email", "message", "disable_email_notification") {
+		var disableEmailNotification *bool
+		if v, ok := diff.GetOkExists("disable_email_notification"); ok {
+			disableEmailNotification = aws.Bool(v.(bool))
+		}
+
+		if err := diff.SetNew("invitation_email_notification_disabled", detectiveInvitationRequestDisableEmailNotification(disableEmailNotification, meta)); err != nil {
+			return fmt.Errorf("error setting invitation_email_notification_disabled: %w", err)
+		}
 	}
+
+	return nil
 }
 
 func resourceDetectiveInvitationRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return detectiveInvitationRequestInvite(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+}
+
+// detectiveInvitationRequestInvite invites the member account, then waits for the invitation to be sent.
+// Inviting the member and waiting for the invitation share the specified timeout.
+func detectiveInvitationRequestInvite(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
 	conn := meta.(*AWSClient).detectiveconn
+	deadline := time.Now().Add(timeout)
+
+	var disableEmailNotification *bool
+	if v, ok := d.GetOkExists("disable_email_notification"); ok {
+		disableEmailNotification = aws.Bool(v.(bool))
+	}
 
 	input := &detective.CreateMembersInput{
 		GraphArn:                 aws.String(d.Get("graph_arn").(string)),
-		DisableEmailNotification: aws.Bool(d.Get("disable_email_notification").(bool)),
+		DisableEmailNotification: aws.Bool(detectiveInvitationRequestDisableEmailNotification(disableEmailNotification, meta)),
 		Accounts: []*detective.Account{
 			{
 				AccountId:    aws.String(d.Get("account").(string)),
@@ -75,10 +159,17 @@ func resourceDetectiveInvitationRequestCreate(ctx context.Context, d *schema.Res
 		input.Message = aws.String(v.(string))
 	}
 
+	id := aws.StringValue(input.GraphArn) + IdSeparator + aws.StringValue(input.Accounts[0].AccountId)
+
 	var err error
 	var res *detective.CreateMembersOutput
-	err = resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
 		res, err = conn.CreateMembersWithContext(ctx, input)
+
+		if tfdetective.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -90,12 +181,31 @@ func resourceDetectiveInvitationRequestCreate(ctx context.Context, d *schema.Res
 		res, err = conn.CreateMembersWithContext(ctx, input)
 	}
 
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeServiceQuotaExceededException) {
+		members, listErr := finder.Members(ctx, conn, aws.StringValue(input.GraphArn))
+
+		if listErr != nil {
+			log.Printf("[WARN] error listing Detective Graph (%s) members: %s", aws.StringValue(input.GraphArn), listErr)
+			return diag.FromErr(detectiveInvitationRequestQuotaError(id, -1, err))
+		}
+
+		return diag.FromErr(detectiveInvitationRequestQuotaError(id, len(members), err))
+	}
+
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error inviting member: %w", err))
+		return diag.FromErr(fmt.Errorf("error creating Detective Invitation Request (%s): %w", id, err))
+	}
+
+	if err := detectiveInvitationRequestUnprocessedError(id, res.UnprocessedAccounts); err != nil {
+		return diag.FromErr(err)
 	}
 
-	id := *input.GraphArn + IdSeparator + *input.Accounts[0].AccountId
 	d.SetId(id)
+	d.Set("invitation_email_notification_disabled", aws.BoolValue(input.DisableEmailNotification))
+
+	if _, err := waiter.MemberInvited(ctx, conn, aws.StringValue(input.GraphArn), aws.StringValue(input.Accounts[0].AccountId), time.Until(deadline)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Detective Invitation Request (%s) to be sent: %w", d.Id(), err))
+	}
 
 	return resourceDetectiveInvitationRequestRead(ctx, d, meta)
 }
@@ -105,39 +215,41 @@ func resourceDetectiveInvitationRequestRead(ctx context.Context, d *schema.Resou
 
 	invitationInfo := strings.Split(d.Id(), IdSeparator)
 
-	input := &detective.GetMembersInput{
-		GraphArn: aws.String(invitationInfo[0]),
-		AccountIds: []*string{
-			aws.String(invitationInfo[1]),
-		},
+	// A member that was just invited may not be listed yet, while a member deleted out of band is removed from state shortly.
+	timeout := detectiveInvitationRequestMemberRefreshedTimeout
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutRead)
 	}
 
-	resp, err := conn.GetMembersWithContext(ctx, input)
+	member, err := detectiveInvitationRequestMember(ctx, conn, invitationInfo[0], invitationInfo[1], timeout)
 
-	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
-		log.Printf("[WARN] Graph or member does not seem to exist for invitation (%s), removing from state", d.Id())
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Invitation Request (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		d.SetId("")
-		return diag.FromErr(fmt.Errorf("error reading Detective member invitation (%s): %w", d.Id(), err))
-	}
-
-	if len(resp.MemberDetails) == 0 {
-		d.SetId("")
-		return nil // diag.FromErr(fmt.Errorf("error reading Detective member invitation (%s)", d.Id()))
+		return diag.FromErr(fmt.Errorf("error reading Detective Invitation Request (%s): %w", d.Id(), err))
 	}
 
-	d.Set("graph_arn", resp.MemberDetails[0].GraphArn)
-	d.Set("account", resp.MemberDetails[0].AccountId)
-	d.Set("email", resp.MemberDetails[0].EmailAddress)
-	d.Set("status", resp.MemberDetails[0].Status)
+	d.Set("graph_arn", member.GraphArn)
+	d.Set("account", member.AccountId)
+	d.Set("email", member.EmailAddress)
+	// The inviting account is the administrator account of the behavior graph.
+	d.Set("administrator_id", member.MasterId)
+	d.Set("status", member.Status)
+	d.Set("disabled_reason", member.DisabledReason)
+	d.Set("disabled_reason_description", tfdetective.MemberDisabledReasonDescription(aws.StringValue(member.DisabledReason)))
 	return nil
 }
 
 func resourceDetectiveInvitationRequestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return detectiveInvitationRequestRemove(ctx, d, meta, d.Timeout(schema.TimeoutDelete))
+}
+
+// detectiveInvitationRequestRemove removes the member account from the behavior graph, retrying when throttled.
+func detectiveInvitationRequestRemove(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
 	conn := meta.(*AWSClient).detectiveconn
 
 	invitationInfo := strings.Split(d.Id(), IdSeparator)
@@ -149,9 +261,13 @@ func resourceDetectiveInvitationRequestDelete(ctx context.Context, d *schema.Res
 		},
 	}
 
-	err := resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		_, err := conn.DeleteMembersWithContext(ctx, input)
 
+		if tfdetective.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -163,7 +279,7 @@ func resourceDetectiveInvitationRequestDelete(ctx context.Context, d *schema.Res
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error delete Detective graph (%s): %w", d.Id(), err))
+		return diag.FromErr(fmt.Errorf("error deleting Detective Invitation Request (%s): %w", d.Id(), err))
 	}
 
 	return nil
@@ -172,15 +288,115 @@ func resourceDetectiveInvitationRequestDelete(ctx context.Context, d *schema.Res
 func resourceDetectiveInvitationRequestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diagnostics diag.Diagnostics
 
-	diagnostics = resourceDetectiveInvitationRequestDelete(ctx, d, meta)
+	// The member is re-invited with the new configuration, including disable_email_notification and message.
+	// Removing and inviting the member again share the update timeout.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+	diagnostics = detectiveInvitationRequestRemove(ctx, d, meta, time.Until(deadline))
 	if diagnostics != nil {
 		return diagnostics
 	}
 
-	diagnostics = resourceDetectiveInvitationRequestCreate(ctx, d, meta)
-	if diagnostics != nil {
-		return diagnostics
+	return detectiveInvitationRequestInvite(ctx, d, meta, time.Until(deadline))
+}
+
+// detectiveInvitationRequestMember returns the member account of the behavior graph.
+// GetMembers can report no member details for a member that exists, e.g. right after it was invited,
+// so the members of the graph are listed until the member is found or the timeout elapses before
+// a NotFoundError is returned. The members are listed once when the timeout is zero.
+func detectiveInvitationRequestMember(ctx context.Context, conn *detective.Detective, graphARN, accountID string, timeout time.Duration) (*detective.MemberDetail, error) {
+	member, err := finder.MemberByGraphARNAndAccountID(ctx, conn, graphARN, accountID)
+
+	if !tfresource.NotFound(err) {
+		return member, err
+	}
+
+	// The behavior graph no longer exists.
+	var notFoundErr *resource.NotFoundError
+	if errors.As(err, &notFoundErr) && tfawserr.ErrCodeEquals(notFoundErr.LastError, detective.ErrCodeResourceNotFoundException) {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Detective member (%s) of graph (%s) not returned by GetMembers, listing the graph members", accountID, graphARN)
+
+	if timeout <= 0 {
+		return detectiveInvitationRequestListedMember(ctx, conn, graphARN, accountID)
+	}
+
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		member, err = detectiveInvitationRequestListedMember(ctx, conn, graphARN, accountID)
+
+		if tfresource.NotFound(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		member, err = detectiveInvitationRequestListedMember(ctx, conn, graphARN, accountID)
+	}
+
+	return member, err
+}
+
+// detectiveInvitationRequestListedMember returns the member account from the listed members of the behavior graph.
+func detectiveInvitationRequestListedMember(ctx context.Context, conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	members, err := finder.Members(ctx, conn, graphARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		if aws.StringValue(member.AccountId) == accountID {
+			return member, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: "no member " + accountID + " listed for graph " + graphARN,
+	}
+}
+
+// detectiveInvitationRequestDisableEmailNotification returns whether the invitation is sent without email notification.
+// The resource setting is used when it is set, otherwise the provider setting applies, i.e. when disableEmailNotification is nil.
+func detectiveInvitationRequestDisableEmailNotification(disableEmailNotification *bool, meta interface{}) bool {
+	if disableEmailNotification != nil {
+		return aws.BoolValue(disableEmailNotification)
+	}
+
+	return meta.(*AWSClient).DisableInvitationEmailNotifications
+}
+
+// detectiveInvitationRequestUnprocessedError returns an error describing the accounts that CreateMembers did not process, if any.
+func detectiveInvitationRequestUnprocessedError(id string, unprocessedAccounts []*detective.UnprocessedAccount) error {
+	if len(unprocessedAccounts) == 0 {
+		return nil
+	}
+
+	reason := aws.StringValue(unprocessedAccounts[0].Reason)
+	err := fmt.Errorf("error creating Detective Invitation Request (%s): account (%s) not processed: %s", id, aws.StringValue(unprocessedAccounts[0].AccountId), reason)
+
+	// The raw reason is kept for debugging, the guidance is appended for the common reasons.
+	if guidance := tfdetective.UnprocessedAccountGuidance(reason); guidance != "" {
+		err = fmt.Errorf("%w: %s", err, guidance)
+	}
+
+	return err
+}
+
+// detectiveInvitationRequestQuotaError returns an error explaining that the behavior graph has reached its member account quota.
+// The current number of member accounts is included when it is known, i.e. when memberCount is not negative.
+func detectiveInvitationRequestQuotaError(id string, memberCount int, err error) error {
+	count := "an unknown number of"
+	if memberCount >= 0 {
+		count = strconv.Itoa(memberCount)
 	}
 
-	return resourceMacie2AccountRead(ctx, d, meta)
+	return fmt.Errorf("error creating Detective Invitation Request (%s): the behavior graph has %s member accounts and reached its quota of %d member accounts, remove unused member accounts or request a quota increase from AWS Support: %w", id, count, detectiveGraphMemberAccountsQuota, err)
 }
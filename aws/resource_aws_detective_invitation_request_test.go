@@ -0,0 +1,405 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestDetectiveInvitationRequestUnprocessedError(t *testing.T) {
+	id := "arn:aws:detective:us-east-1:123456789012:graph:a/210987654321" //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		Name                string
+		UnprocessedAccounts []*detective.UnprocessedAccount
+		ExpectedError       string
+	}{
+		{
+			Name: "no unprocessed accounts",
+		},
+		{
+			Name: "unprocessed account",
+			UnprocessedAccounts: []*detective.UnprocessedAccount{
+				{
+					AccountId: aws.String("210987654321"),
+					Reason:    aws.String("The request is rejected because the account is already a member"),
+				},
+			},
+			ExpectedError: "error creating Detective Invitation Request (" + id + "): account (210987654321) not processed: The request is rejected because the account is already a member: the account is already a member of the behavior graph, import the existing member",
+		},
+		{
+			Name: "unprocessed account unknown reason",
+			UnprocessedAccounts: []*detective.UnprocessedAccount{
+				{
+					AccountId: aws.String("210987654321"),
+					Reason:    aws.String("Something went wrong"),
+				},
+			},
+			ExpectedError: "error creating Detective Invitation Request (" + id + "): account (210987654321) not processed: Something went wrong",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := detectiveInvitationRequestUnprocessedError(id, testCase.UnprocessedAccounts)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("got unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if got := err.Error(); got != testCase.ExpectedError {
+				t.Errorf("got %q, expected %q", got, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestDetectiveInvitationRequestMember(t *testing.T) {
+	graphARN := "arn:aws:detective:us-east-1:123456789012:graph:a" //lintignore:AWSAT003,AWSAT005
+	member := &detective.MemberDetail{
+		AccountId: aws.String("210987654321"),
+		GraphArn:  aws.String(graphARN),
+	}
+	other := &detective.MemberDetail{
+		AccountId: aws.String("111111111111"),
+		GraphArn:  aws.String(graphARN),
+	}
+
+	testCases := []struct {
+		Name              string
+		GetMembersError   error
+		GetMembersDetails []*detective.MemberDetail
+		ListMembers       []*detective.MemberDetail
+		Timeout           time.Duration
+		ExpectedMember    *detective.MemberDetail
+		ExpectedNotFound  bool
+		ExpectedListCalls bool
+	}{
+		{
+			Name:              "returned by GetMembers",
+			GetMembersDetails: []*detective.MemberDetail{member},
+			ExpectedMember:    member,
+		},
+		{
+			Name:              "GetMembers empty but listed",
+			ListMembers:       []*detective.MemberDetail{other, member},
+			Timeout:           time.Millisecond,
+			ExpectedMember:    member,
+			ExpectedListCalls: true,
+		},
+		{
+			Name:              "GetMembers empty and not listed",
+			ListMembers:       []*detective.MemberDetail{other},
+			Timeout:           time.Millisecond,
+			ExpectedNotFound:  true,
+			ExpectedListCalls: true,
+		},
+		{
+			Name:              "GetMembers empty but listed without timeout",
+			ListMembers:       []*detective.MemberDetail{other, member},
+			ExpectedMember:    member,
+			ExpectedListCalls: true,
+		},
+		{
+			Name:              "GetMembers empty and not listed without timeout",
+			ListMembers:       []*detective.MemberDetail{other},
+			ExpectedNotFound:  true,
+			ExpectedListCalls: true,
+		},
+		{
+			Name:             "graph not found",
+			GetMembersError:  awserr.New(detective.ErrCodeResourceNotFoundException, "The request refers to a nonexistent resource", nil),
+			ExpectedNotFound: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := detective.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var listCalls int
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *detective.GetMembersOutput:
+					r.Error = testCase.GetMembersError
+					data.MemberDetails = testCase.GetMembersDetails
+				case *detective.ListMembersOutput:
+					listCalls++
+					data.MemberDetails = testCase.ListMembers
+				}
+			})
+
+			got, err := detectiveInvitationRequestMember(context.Background(), conn, graphARN, "210987654321", testCase.Timeout)
+
+			if testCase.ExpectedListCalls != (listCalls > 0) {
+				t.Errorf("got %d ListMembers calls, expected calls: %t", listCalls, testCase.ExpectedListCalls)
+			}
+
+			if testCase.ExpectedNotFound {
+				if !tfresource.NotFound(err) {
+					t.Errorf("expected NotFoundError, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedMember {
+				t.Errorf("got %v, expected %v", got, testCase.ExpectedMember)
+			}
+		})
+	}
+}
+
+func TestDetectiveInvitationRequestDisableEmailNotification(t *testing.T) {
+	testCases := []struct {
+		Name                     string
+		DisableEmailNotification *bool
+		ProviderDisabled         bool
+		Expected                 bool
+	}{
+		{
+			Name: "not set",
+		},
+		{
+			Name:                     "resource disabled",
+			DisableEmailNotification: aws.Bool(true),
+			Expected:                 true,
+		},
+		{
+			Name:             "not set provider disabled",
+			ProviderDisabled: true,
+			Expected:         true,
+		},
+		{
+			Name:                     "resource enabled provider disabled",
+			DisableEmailNotification: aws.Bool(false),
+			ProviderDisabled:         true,
+			Expected:                 false,
+		},
+		{
+			Name:                     "both disabled",
+			DisableEmailNotification: aws.Bool(true),
+			ProviderDisabled:         true,
+			Expected:                 true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			meta := &AWSClient{DisableInvitationEmailNotifications: testCase.ProviderDisabled}
+
+			if got := detectiveInvitationRequestDisableEmailNotification(testCase.DisableEmailNotification, meta); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDetectiveInvitationRequestQuotaError(t *testing.T) {
+	id := "arn:aws:detective:us-east-1:123456789012:graph:a/210987654321" //lintignore:AWSAT003,AWSAT005
+	err := errors.New("ServiceQuotaExceededException: quota exceeded")
+
+	testCases := []struct {
+		Name          string
+		MemberCount   int
+		ExpectedError string
+	}{
+		{
+			Name:          "member count",
+			MemberCount:   1200,
+			ExpectedError: "error creating Detective Invitation Request (" + id + "): the behavior graph has 1200 member accounts and reached its quota of 1200 member accounts, remove unused member accounts or request a quota increase from AWS Support: ServiceQuotaExceededException: quota exceeded",
+		},
+		{
+			Name:          "unknown member count",
+			MemberCount:   -1,
+			ExpectedError: "error creating Detective Invitation Request (" + id + "): the behavior graph has an unknown number of member accounts and reached its quota of 1200 member accounts, remove unused member accounts or request a quota increase from AWS Support: ServiceQuotaExceededException: quota exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := detectiveInvitationRequestQuotaError(id, testCase.MemberCount, err)
+
+			if got.Error() != testCase.ExpectedError {
+				t.Errorf("got %q, expected %q", got.Error(), testCase.ExpectedError)
+			}
+
+			if !errors.Is(got, err) {
+				t.Errorf("expected the quota error to wrap %q", err)
+			}
+		})
+	}
+}
+
+func testAccAwsDetectiveInvitationRequest_disableEmailNotification(t *testing.T) {
+	var providers []*schema.Provider
+	var member detective.MemberDetail
+	resourceName := "aws_detective_invitation_request.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveAlternateEmail, EnvVarDetectiveAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAcceptDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveInvitationRequestConfigDisableEmailNotification(email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveInvitationRequestExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "disable_email_notification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "invitation_email_notification_disabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+					testAccCheckResourceAttrAccountID(resourceName, "administrator_id"),
+				),
+			},
+			{
+				Config: testAccAwsDetectiveInvitationRequestConfigDisableEmailNotification(email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveInvitationRequestExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "disable_email_notification", "false"),
+					resource.TestCheckResourceAttr(resourceName, "invitation_email_notification_disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveInvitationRequest_selfInvite(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAcceptDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsDetectiveInvitationRequestConfigSelfInvite(),
+				ExpectError: regexp.MustCompile(`is the behavior graph administrator account and cannot be invited`),
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveInvitationRequest_regionMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAcceptDestroy,
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsDetectiveInvitationRequestConfigRegionMismatch(testAccGetAlternateRegion()),
+				ExpectError: regexp.MustCompile(`not in the provider Region`),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveInvitationRequestExists(resourceName string, member *detective.MemberDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource (%s) has empty ID", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		output, err := finder.MemberByGraphARNAndAccountID(context.Background(), conn, rs.Primary.Attributes["graph_arn"], rs.Primary.Attributes["account"])
+
+		if err != nil {
+			return err
+		}
+
+		*member = *output
+
+		return nil
+	}
+}
+
+func testAccAwsDetectiveInvitationRequestConfigDisableEmailNotification(email string, disableEmailNotification bool) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "admin" {}
+
+resource "aws_detective_invitation_request" "member" {
+  graph_arn                  = aws_detective_graph.admin.id
+  account                    = data.aws_caller_identity.member.account_id
+  email                      = %[1]q
+  disable_email_notification = %[2]t
+}
+`, email, disableEmailNotification)
+}
+
+func testAccAwsDetectiveInvitationRequestConfigSelfInvite() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_detective_graph" "admin" {}
+
+resource "aws_detective_invitation_request" "member" {
+  graph_arn = aws_detective_graph.admin.id
+  account   = data.aws_caller_identity.current.account_id
+  email     = "required@example.com"
+}
+`
+}
+
+func testAccAwsDetectiveInvitationRequestConfigRegionMismatch(region string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_detective_invitation_request" "member" {
+  graph_arn = "arn:${data.aws_partition.current.partition}:detective:%[1]s:${data.aws_caller_identity.current.account_id}:graph:abcdef0123456789abcdef0123456789"
+  account   = "111111111111"
+  email     = "required@example.com"
+}
+`, region)
+}
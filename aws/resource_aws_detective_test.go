@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestAccAWSDetective_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"AdministratorDataSource": {
+			"basic": testAccAwsDetectiveAdministratorDataSource_basic,
+		},
+		"Graph": {
+			"basic":          testAccAwsDetectiveGraph_basic,
+			"default_tags":   testAccAwsDetectiveGraph_defaultTags,
+			"pending_member": testAccAwsDetectiveGraph_pendingMember,
+			"tags":           testAccAwsDetectiveGraph_tags,
+		},
+		"GraphDataSource": {
+			"basic": testAccAwsDetectiveGraphDataSource_basic,
+		},
+		"GraphsDataSource": {
+			"basic": testAccAwsDetectiveGraphsDataSource_basic,
+		},
+		"InvitationsDataSource": {
+			"basic": testAccAwsDetectiveInvitationsDataSource_basic,
+		},
+		"MemberDataSource": {
+			"basic": testAccAwsDetectiveMemberDataSource_basic,
+		},
+		"MemberGraphDataSource": {
+			"basic": testAccAwsDetectiveMemberGraphDataSource_basic,
+		},
+		"InvitationAccept": {
+			"basic":             testAccAwsDetectiveInvitationAccept_basic,
+			"graph_arn_unknown": testAccAwsDetectiveInvitationAccept_GraphArnUnknown,
+			"graph_arns":        testAccAwsDetectiveInvitationAccept_GraphArns,
+			"import_accepted":   testAccAwsDetectiveInvitationAccept_importAccepted,
+			"region_mismatch":   testAccAwsDetectiveInvitationAccept_regionMismatch,
+		},
+		"InvitationRequest": {
+			"disable_email_notification": testAccAwsDetectiveInvitationRequest_disableEmailNotification,
+			"region_mismatch":            testAccAwsDetectiveInvitationRequest_regionMismatch,
+			"self_invite":                testAccAwsDetectiveInvitationRequest_selfInvite,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/waiter"
 )
 
 func resourceAwsMacie2Account() *schema.Resource {
@@ -30,7 +32,7 @@ func resourceAwsMacie2Account() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringInSlice(macie2.FindingPublishingFrequency_Values(), false),
+				ValidateFunc: tfmacie2.ValidateFindingPublishingFrequency,
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -51,6 +53,10 @@ func resourceAwsMacie2Account() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(1 * time.Minute),
+		},
 	}
 }
 
@@ -102,8 +108,7 @@ func resourceMacie2AccountRead(ctx context.Context, d *schema.ResourceData, meta
 
 	resp, err := conn.GetMacieSessionWithContext(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+	if tfmacie2.IsNotFoundError(err) {
 		log.Printf("[WARN] Macie not enabled for AWS account (%s), removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -140,6 +145,13 @@ func resourceMacie2AccountUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(fmt.Errorf("error updating Macie Account (%s): %w", d.Id(), err))
 	}
 
+	// The finding publishing frequency is updated asynchronously in some Regions.
+	if input.FindingPublishingFrequency != nil {
+		if _, err := waiter.AccountFindingPublishingFrequencyUpdated(ctx, conn, aws.StringValue(input.FindingPublishingFrequency), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for Macie Account (%s) finding publishing frequency update: %w", d.Id(), err))
+		}
+	}
+
 	return resourceMacie2AccountRead(ctx, d, meta)
 }
 
@@ -156,8 +168,7 @@ func resourceMacie2AccountDelete(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		if err != nil {
-			if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-				tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			if tfmacie2.IsNotFoundError(err) {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -171,8 +182,7 @@ func resourceMacie2AccountDelete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		if tfmacie2.IsNotFoundError(err) {
 			return nil
 		}
 		return diag.FromErr(fmt.Errorf("error disabling Macie Account (%s): %w", d.Id(), err))
@@ -1,15 +1,61 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
 )
 
+func TestIsMacie2NotFoundError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil error",
+		},
+		{
+			Name: "other error",
+			Err:  errors.New("test"),
+		},
+		{
+			Name:     "resource not found",
+			Err:      awserr.New(macie2.ErrCodeResourceNotFoundException, "test", nil),
+			Expected: true,
+		},
+		{
+			Name:     "Macie not enabled",
+			Err:      awserr.New(macie2.ErrCodeAccessDeniedException, "Macie is not enabled", nil),
+			Expected: true,
+		},
+		{
+			Name: "other access denied",
+			Err:  awserr.New(macie2.ErrCodeAccessDeniedException, "test", nil),
+		},
+		{
+			Name:     "wrapped resource not found",
+			Err:      fmt.Errorf("test: %w", awserr.New(macie2.ErrCodeResourceNotFoundException, "test", nil)),
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := tfmacie2.IsNotFoundError(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func testAccAwsMacie2Account_basic(t *testing.T) {
 	var macie2Output macie2.GetMacieSessionOutput
 	resourceName := "aws_macie2_account.test"
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+)
+
+func resourceAwsMacie2AdministratorDisassociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMacie2AdministratorDisassociationCreate,
+		ReadWithoutTimeout:   resourceMacie2AdministratorDisassociationRead,
+		DeleteWithoutTimeout: resourceMacie2AdministratorDisassociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"administrator_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMacie2AdministratorDisassociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+
+	adminAccountID := d.Get("administrator_account_id").(string)
+
+	administrator, err := macie2AdministratorAccount(ctx, conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie Administrator Disassociation (%s): %w", adminAccountID, err))
+	}
+
+	// Only the relationship with the given administrator account is ended, an account that already left it is fine.
+	if administrator != nil && aws.StringValue(administrator.AccountId) == adminAccountID && macie2AdministratorAssociated(administrator) {
+		_, err := conn.DisassociateFromAdministratorAccountWithContext(ctx, &macie2.DisassociateFromAdministratorAccountInput{})
+
+		if err != nil && !tfmacie2.IsNotFoundError(err) {
+			return diag.FromErr(fmt.Errorf("error creating Macie Administrator Disassociation (%s): %w", adminAccountID, err))
+		}
+	}
+
+	d.SetId(adminAccountID)
+
+	return resourceMacie2AdministratorDisassociationRead(ctx, d, meta)
+}
+
+func resourceMacie2AdministratorDisassociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+
+	administrator, err := macie2AdministratorAccount(ctx, conn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie Administrator Disassociation (%s): %w", d.Id(), err))
+	}
+
+	d.Set("administrator_account_id", d.Id())
+
+	if administrator == nil || aws.StringValue(administrator.AccountId) != d.Id() {
+		d.Set("relationship_status", nil)
+		return nil
+	}
+
+	// The account is associated again with the administrator account, the disassociation no longer holds.
+	if !d.IsNewResource() && macie2AdministratorAssociated(administrator) {
+		log.Printf("[WARN] Macie Administrator Disassociation (%s) no longer holds, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("relationship_status", administrator.RelationshipStatus)
+
+	return nil
+}
+
+func resourceMacie2AdministratorDisassociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A member account cannot associate itself again with its administrator account, a new invitation is needed.
+	log.Printf("[WARN] Macie Administrator Disassociation (%s) removed from state, the account is not associated again with the administrator account", d.Id())
+
+	return nil
+}
+
+// macie2AdministratorAccount returns the administrator account of the current account, if any.
+func macie2AdministratorAccount(ctx context.Context, conn *macie2.Macie2) (*macie2.Invitation, error) {
+	output, err := conn.GetAdministratorAccountWithContext(ctx, &macie2.GetAdministratorAccountInput{})
+
+	if tfmacie2.IsNotFoundError(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Administrator, nil
+}
+
+// macie2AdministratorAssociated returns whether the relationship with the administrator account is active.
+func macie2AdministratorAssociated(administrator *macie2.Invitation) bool {
+	switch aws.StringValue(administrator.RelationshipStatus) {
+	case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused:
+		return true
+	}
+
+	return false
+}
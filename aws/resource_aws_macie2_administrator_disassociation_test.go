@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+func testAccAwsMacie2AdministratorDisassociation_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_macie2_administrator_disassociation.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2PrincipalEmail, EnvVarMacie2PrincipalEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2InvitationAccepterDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieAdministratorDisassociationConfigBasic(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AdministratorDisassociated(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "administrator_account_id", "data.aws_caller_identity.admin", "account_id"),
+				),
+			},
+			{
+				Config:            testAccAwsMacieAdministratorDisassociationConfigBasic(email),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2AdministratorDisassociated(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource (%s) has empty ID", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		administrator, err := macie2AdministratorAccount(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if administrator != nil && aws.StringValue(administrator.AccountId) == rs.Primary.ID && macie2AdministratorAssociated(administrator) {
+			return fmt.Errorf("account is still associated with Macie administrator account (%s)", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsMacieAdministratorDisassociationConfigBasic(email string) string {
+	return composeConfig(testAccAwsMacieInvitationAccepterConfigBasic(email), `
+resource "aws_macie2_administrator_disassociation" "member" {
+  administrator_account_id = data.aws_caller_identity.admin.account_id
+  depends_on               = [aws_macie2_invitation_accepter.member]
+}
+`)
+}
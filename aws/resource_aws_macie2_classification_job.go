@@ -10,11 +10,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/waiter"
 )
 
 func resourceAwsMacie2ClassificationJob() *schema.Resource {
@@ -26,6 +29,10 @@ func resourceAwsMacie2ClassificationJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceMacie2ClassificationJobCustomizeDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"custom_data_identifier_ids": {
 				Type:     schema.TypeList,
@@ -360,8 +367,24 @@ func resourceAwsMacie2ClassificationJob() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+		},
+	}
+}
+
+func resourceMacie2ClassificationJobCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Only the run of a one-time job completes, scheduled jobs keep running until they are cancelled.
+	if diff.Get("wait_for_completion").(bool) && diff.NewValueKnown("job_type") && diff.Get("job_type").(string) != macie2.JobTypeOneTime {
+		return fmt.Errorf("\"wait_for_completion\" can only be set when \"job_type\" is %s", macie2.JobTypeOneTime)
 	}
+
+	return nil
 }
 
 func resourceMacie2ClassificationJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -400,7 +423,7 @@ func resourceMacie2ClassificationJobCreate(ctx context.Context, d *schema.Resour
 
 	var err error
 	var output *macie2.CreateClassificationJobOutput
-	err = resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		output, err = conn.CreateClassificationJobWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -423,6 +446,12 @@ func resourceMacie2ClassificationJobCreate(ctx context.Context, d *schema.Resour
 
 	d.SetId(aws.StringValue(output.JobId))
 
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waiter.ClassificationJobCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for Macie ClassificationJob (%s) to complete: %w", d.Id(), err))
+		}
+	}
+
 	return resourceMacie2ClassificationJobRead(ctx, d, meta)
 }
 
@@ -437,8 +466,7 @@ func resourceMacie2ClassificationJobRead(ctx context.Context, d *schema.Resource
 
 	resp, err := conn.DescribeClassificationJobWithContext(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") ||
+	if tfmacie2.IsNotFoundError(err) ||
 		tfawserr.ErrMessageContains(err, macie2.ErrCodeValidationException, "cannot update cancelled job for job") {
 		log.Printf("[WARN] Macie ClassificationJob (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -503,11 +531,19 @@ func resourceMacie2ClassificationJobUpdate(ctx context.Context, d *schema.Resour
 		}
 
 		input.JobStatus = aws.String(status)
+
+		_, err := conn.UpdateClassificationJobWithContext(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s): %w", d.Id(), err))
+		}
 	}
 
-	_, err := conn.UpdateClassificationJobWithContext(ctx, input)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s): %w", d.Id(), err))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("job_arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s) tags: %w", d.Id(), err))
+		}
 	}
 
 	return resourceMacie2ClassificationJobRead(ctx, d, meta)
@@ -523,8 +559,7 @@ func resourceMacie2ClassificationJobDelete(ctx context.Context, d *schema.Resour
 
 	_, err := conn.UpdateClassificationJobWithContext(ctx, input)
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") ||
+		if tfmacie2.IsNotFoundError(err) ||
 			tfawserr.ErrMessageContains(err, macie2.ErrCodeValidationException, "cannot update cancelled job for job") {
 			return nil
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
 )
 
 func resourceAwsMacie2FindingsFilter() *schema.Resource {
@@ -26,6 +27,7 @@ func resourceAwsMacie2FindingsFilter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceMacie2FindingsFilterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"finding_criteria": {
 				Type:     schema.TypeList,
@@ -190,8 +192,7 @@ func resourceMacie2FindingsFilterRead(ctx context.Context, d *schema.ResourceDat
 
 	resp, err := conn.GetFindingsFilterWithContext(ctx, input)
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		if tfmacie2.IsNotFoundError(err) {
 			log.Printf("[WARN] Macie FindingsFilter (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -269,8 +270,7 @@ func resourceMacie2FindingsFilterDelete(ctx context.Context, d *schema.ResourceD
 
 	_, err := conn.DeleteFindingsFilterWithContext(ctx, input)
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		if tfmacie2.IsNotFoundError(err) {
 			return nil
 		}
 		return diag.FromErr(fmt.Errorf("error deleting Macie FindingsFilter (%s): %w", d.Id(), err))
@@ -278,6 +278,36 @@ func resourceMacie2FindingsFilterDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
+func resourceMacie2FindingsFilterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	criteria, ok := diff.Get("finding_criteria.0.criterion").(*schema.Set)
+
+	if !ok {
+		return nil
+	}
+
+	for _, v := range criteria.List() {
+		criterion, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// Skip fields that are not yet known.
+		field, ok := criterion["field"].(string)
+
+		if !ok || field == "" {
+			continue
+		}
+
+		// Fields missing from the known fields, e.g. fields added to Amazon Macie later, are only reported.
+		if err := tfmacie2.ValidateFindingCriterionField(field); err != nil {
+			log.Printf("[WARN] Macie FindingsFilter finding_criteria: %s", err)
+		}
+	}
+
+	return nil
+}
+
 func expandFindingCriteriaFilter(findingCriterias []interface{}) (*macie2.FindingCriteria, error) {
 	if len(findingCriterias) == 0 {
 		return nil, nil
@@ -2,21 +2,37 @@ package aws
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+// macie2MemberInvitationResendInterval is the minimum interval between two invitations sent to the same member account.
+const macie2MemberInvitationResendInterval = 5 * time.Minute
+
+// macie2MemberRemovals records when member accounts were deleted by the provider, keyed by account ID.
+// Amazon Macie reports no removal in progress status, a member created again right after it was deleted
+// can still be associated with the administrator account for a while.
+var macie2MemberRemovals sync.Map
+
 func resourceAwsMacie2Member() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMacie2MemberCreate,
@@ -26,6 +42,11 @@ func resourceAwsMacie2Member() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceMacie2MemberInheritedTagsCustomizeDiff,
+			resourceMacie2MemberCustomizeDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:     schema.TypeString,
@@ -33,11 +54,23 @@ func resourceAwsMacie2Member() *schema.Resource {
 				ForceNew: true,
 			},
 			"email": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMemberAccountEmail,
+				// Members enabled through the organization report the email address registered for the account.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("organization_managed").(bool) || macie2MemberNormalizeEmail(old) == macie2MemberNormalizeEmail(new)
+				},
 			},
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
+			"inherit_account_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"inherited_tags": tagsSchemaComputed(),
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,10 +91,22 @@ func resourceAwsMacie2Member() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"invitation_age_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"organization_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"updated_at": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"finding_publishing_frequency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -73,59 +118,131 @@ func resourceAwsMacie2Member() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"on_invite_false": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      tfmacie2.MemberOnInviteFalseDisassociate,
+				ValidateFunc: validation.StringInSlice(tfmacie2.MemberOnInviteFalse_Values(), false),
+			},
 			"invitation_disable_email_notification": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"invite"},
 			},
 			"invitation_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"invite"},
+			},
+			"invitation_message_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_invited_at": {
 				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fail_on_unprocessed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"strict": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 		},
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(60 * time.Second),
-			Update: schema.DefaultTimeout(60 * time.Second),
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Second),
 		},
 	}
 }
 
-func resourceMacie2MemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).macie2conn
+// resourceMacie2MemberInheritedTagsCustomizeDiff adds the tags inherited from the administrator account to tags_all,
+// which SetTagsDiff only computes from the provider default tags and the resource tags.
+func resourceMacie2MemberInheritedTagsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("inherit_account_tags").(bool) {
+		return nil
+	}
+
+	// The account tags are only known once they are read at creation.
+	if diff.Id() == "" {
+		return diff.SetNewComputed("tags_all")
+	}
 
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
-	accountId := d.Get("account_id").(string)
-	input := &macie2.CreateMemberInput{
-		Account: &macie2.AccountDetail{
-			AccountId: aws.String(accountId),
-			Email:     aws.String(d.Get("email").(string)),
-		},
+	inheritedTags := keyvaluetags.New(diff.Get("inherited_tags").(map[string]interface{}))
+	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
+	allTags := inheritedTags.Merge(defaultTagsConfig.MergeTags(resourceTags)).IgnoreConfig(ignoreTagsConfig)
+
+	if len(allTags) == 0 {
+		return nil
 	}
 
-	if len(tags) > 0 {
-		input.Tags = tags.IgnoreAws().Macie2Tags()
+	if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
+		return fmt.Errorf("error setting new tags_all diff: %w", err)
 	}
 
-	var err error
-	err = resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
-		_, err := conn.CreateMemberWithContext(ctx, input)
+	return nil
+}
 
-		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
-			return resource.RetryableError(err)
-		}
+func resourceMacie2MemberCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("invite") {
+		return nil
+	}
 
-		if err != nil {
-			return resource.NonRetryableError(err)
+	if diff.Get("invite").(bool) {
+		// A new invitation is sent when `invite` is set to true.
+		if diff.Id() != "" && diff.HasChange("invite") {
+			if err := diff.SetNewComputed("last_invited_at"); err != nil {
+				return err
+			}
+
+			return diff.SetNewComputed("invitation_message_hash")
 		}
 
 		return nil
-	})
+	}
 
-	if isResourceTimeoutError(err) {
-		_, err = conn.CreateMemberWithContext(ctx, input)
+	// A paused member is reported as PAUSED, enabling it again would undo the pause on every apply.
+	if diff.Get("on_invite_false").(string) == tfmacie2.MemberOnInviteFalsePause && diff.HasChange("status") && diff.Get("status").(string) == macie2.MacieStatusEnabled {
+		return fmt.Errorf("\"status\" cannot be set to %q when \"invite\" is false and \"on_invite_false\" is %q", macie2.MacieStatusEnabled, tfmacie2.MemberOnInviteFalsePause)
+	}
+
+	// Invitation arguments are only used when the member is invited at creation or when `invite` is later set to true.
+
+	for _, key := range []string{"invitation_message", "invitation_disable_email_notification"} {
+		// Existing values are kept when `invite` is set to false, so that the member can be invited again later.
+		if diff.Id() != "" && !diff.HasChange(key) {
+			continue
+		}
+
+		if _, ok := diff.GetOk(key); ok {
+			return fmt.Errorf("%q can only be set when \"invite\" is true", key)
+		}
+	}
+
+	return nil
+}
+
+func resourceMacie2MemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+
+	accountId := d.Get("account_id").(string)
+
+	if err := macie2MemberWaitPreviousRemoval(ctx, conn, accountId); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for previous Macie Member (%s) removal: %w", accountId, err))
 	}
 
+	// Warnings about account tags that could not be inherited are returned with the refreshed state.
+	warnings, err := macie2MemberCreateRecord(ctx, conn, d, meta, d.Timeout(schema.TimeoutCreate))
+
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Macie Member: %w", err))
 	}
@@ -133,7 +250,11 @@ func resourceMacie2MemberCreate(ctx context.Context, d *schema.ResourceData, met
 	d.SetId(accountId)
 
 	if !d.Get("invite").(bool) {
-		return resourceMacie2MemberRead(ctx, d, meta)
+		if err := macie2MemberApplyStatus(ctx, conn, d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return append(warnings, resourceMacie2MemberRead(ctx, d, meta)...)
 	}
 
 	// Invitation workflow
@@ -142,9 +263,7 @@ func resourceMacie2MemberCreate(ctx context.Context, d *schema.ResourceData, met
 		AccountIds: []*string{aws.String(d.Id())},
 	}
 
-	if v, ok := d.GetOk("invitation_disable_email_notification"); ok {
-		inputInvite.DisableEmailNotification = aws.Bool(v.(bool))
-	}
+	inputInvite.DisableEmailNotification = macie2MemberDisableEmailNotification(d, meta)
 	if v, ok := d.GetOk("invitation_message"); ok {
 		inputInvite.Message = aws.String(v.(string))
 	}
@@ -152,10 +271,10 @@ func resourceMacie2MemberCreate(ctx context.Context, d *schema.ResourceData, met
 	log.Printf("[INFO] Inviting Macie2 Member: %s", inputInvite)
 
 	var output *macie2.CreateInvitationsOutput
-	err = resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		output, err = conn.CreateInvitationsWithContext(ctx, inputInvite)
 
-		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
+		if tfmacie2.IsMemberCreationError(err) || tfmacie2.IsThrottlingError(err) {
 			return resource.RetryableError(err)
 		}
 
@@ -174,15 +293,275 @@ func resourceMacie2MemberCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(fmt.Errorf("error inviting Macie Member: %w", err))
 	}
 
-	if len(output.UnprocessedAccounts) != 0 {
-		return diag.FromErr(fmt.Errorf("error inviting Macie Member: %s: %s", aws.StringValue(output.UnprocessedAccounts[0].ErrorCode), aws.StringValue(output.UnprocessedAccounts[0].ErrorMessage)))
+	if diags := macie2MemberUnprocessedAccountDiagnostics(d.Id(), output.UnprocessedAccounts, d.Get("fail_on_unprocessed").(bool)); diags != nil {
+		if diags.HasError() {
+			return diags
+		}
+
+		// The member is kept with its current relationship status so that the invitation is sent again on the next apply.
+		return append(append(warnings, diags...), resourceMacie2MemberRead(ctx, d, meta)...)
 	}
 
-	if _, err = waiter.MemberInvited(ctx, conn, d.Id()); err != nil {
+	d.Set("invitation_message_hash", macie2MemberInvitationMessageHash(aws.StringValue(inputInvite.Message)))
+	// Unlike invited_at, which Amazon Macie may keep from an earlier invitation, this records when the resource last invited the member.
+	d.Set("last_invited_at", time.Now().UTC().Format(time.RFC3339))
+
+	if _, err = waiter.MemberInvited(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.FromErr(fmt.Errorf("error waiting for Macie Member (%s) invitation: %w", d.Id(), err))
 	}
 
-	return resourceMacie2MemberRead(ctx, d, meta)
+	if err := macie2MemberApplyStatus(ctx, conn, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(warnings, resourceMacie2MemberRead(ctx, d, meta)...)
+}
+
+// macie2MemberApplyStatus sets the configured status of the member, e.g. at creation so that no second apply is needed.
+// The member session can only be updated once the member is associated, e.g. when it is enabled through
+// the organization. Otherwise the status is set on a later apply, after the invitation is accepted.
+func macie2MemberApplyStatus(ctx context.Context, conn *macie2.Macie2, d *schema.ResourceData) error {
+	v, ok := d.GetOk("status")
+
+	if !ok {
+		return nil
+	}
+
+	output, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Member (%s): %w", d.Id(), err)
+	}
+
+	relationshipStatus := aws.StringValue(output.RelationshipStatus)
+
+	// The transition is checked before UpdateMemberSession, which rejects the changes of members that left the administrator account without explanation.
+	switch tfmacie2.MemberStatusTransition(relationshipStatus, v.(string)) {
+	case tfmacie2.MemberStatusTransitionNone:
+		return nil
+	case tfmacie2.MemberStatusTransitionDeferred:
+		log.Printf("[DEBUG] Macie Member (%s) is %s, not setting status %s", d.Id(), relationshipStatus, v.(string))
+		return nil
+	case tfmacie2.MemberStatusTransitionInvalid:
+		return fmt.Errorf("error updating Macie Member (%s) status: status cannot be set to %s while the relationship status is %s, the status can only be set once the member is associated with the administrator account (Enabled or Paused), e.g. after it is invited again and accepts the invitation", d.Id(), v.(string), relationshipStatus)
+	}
+
+	input := &macie2.UpdateMemberSessionInput{
+		Id:     aws.String(d.Id()),
+		Status: aws.String(v.(string)),
+	}
+
+	mutexKey := macie2MemberSessionMutexKey(d.Id())
+	awsMutexKV.Lock(mutexKey)
+	_, err = conn.UpdateMemberSessionWithContext(ctx, input)
+	awsMutexKV.Unlock(mutexKey)
+
+	if err != nil {
+		return fmt.Errorf("error updating Macie Member (%s) status: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// macie2MemberDisableEmailNotification returns whether the invitation is sent without email notification.
+// The resource setting is used when it is set, otherwise the provider setting applies. Nil leaves the API default.
+func macie2MemberDisableEmailNotification(d *schema.ResourceData, meta interface{}) *bool {
+	if v, ok := d.GetOkExists("invitation_disable_email_notification"); ok {
+		return aws.Bool(v.(bool))
+	}
+
+	if meta.(*AWSClient).DisableInvitationEmailNotifications {
+		return aws.Bool(true)
+	}
+
+	return nil
+}
+
+// macie2MemberUnprocessedAccountDiagnostics returns the diagnostics for an invitation that could not be processed.
+// The diagnostics are warnings when failOnUnprocessed is false, so that onboarding many members can partially succeed.
+func macie2MemberUnprocessedAccountDiagnostics(id string, unprocessedAccounts []*macie2.UnprocessedAccount, failOnUnprocessed bool) diag.Diagnostics {
+	if len(unprocessedAccounts) == 0 {
+		return nil
+	}
+
+	errorCode := aws.StringValue(unprocessedAccounts[0].ErrorCode)
+	errorMessage := aws.StringValue(unprocessedAccounts[0].ErrorMessage)
+	err := fmt.Errorf("error inviting Macie Member: %s: %s", errorCode, errorMessage)
+
+	// The raw error code and message are kept for debugging, the guidance is appended for the common errors.
+	if guidance := tfmacie2.UnprocessedAccountGuidance(errorCode, errorMessage); guidance != "" {
+		err = fmt.Errorf("%w: %s", err, guidance)
+	}
+
+	if failOnUnprocessed {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Macie Member (%s) invitation was not processed", id),
+			Detail:   err.Error(),
+		},
+	}
+}
+
+// macie2MemberWaitPreviousRemoval waits for a member of the account that was just deleted by the provider to be removed,
+// as a member created again right after it was deleted can still be associated with the administrator account.
+// Other existing members are left to the creation, which adopts them.
+func macie2MemberWaitPreviousRemoval(ctx context.Context, conn *macie2.Macie2, accountID string) error {
+	v, ok := macie2MemberRemovals.Load(accountID)
+
+	if !ok {
+		return nil
+	}
+
+	macie2MemberRemovals.Delete(accountID)
+
+	timeout := waiter.MemberRemovedTimeout - time.Since(v.(time.Time))
+
+	if timeout <= 0 {
+		return nil
+	}
+
+	output, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{
+		Id: aws.String(accountID),
+	})
+
+	if tfmacie2.IsNotFoundError(err) || isMacie2MemberNotAssociatedError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	switch relationshipStatus := aws.StringValue(output.RelationshipStatus); tfmacie2.MemberState(relationshipStatus) {
+	case tfmacie2.MemberStateAssociated, tfmacie2.MemberStateInvited:
+		log.Printf("[DEBUG] Waiting for previous Macie Member (%s) to be removed, it is %s", accountID, relationshipStatus)
+	default:
+		return nil
+	}
+
+	_, err = waiter.MemberRemoved(ctx, conn, accountID, timeout)
+
+	if tfresource.NotFound(err) || isMacie2MemberNotAssociatedError(err) {
+		return nil
+	}
+
+	return err
+}
+
+// macie2MemberNormalizeEmail returns the email address as it is compared with the one reported by Amazon Macie.
+func macie2MemberNormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// macie2MemberCreateRecord creates the member account record with the configured email and tags.
+// A warning is returned when the account tags cannot be inherited, as listing them requires the
+// AWS Organizations management account or a delegated administrator.
+func macie2MemberCreateRecord(ctx context.Context, conn *macie2.Macie2, d *schema.ResourceData, meta interface{}, timeout time.Duration) (diag.Diagnostics, error) {
+	var warnings diag.Diagnostics
+
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	if d.Get("inherit_account_tags").(bool) {
+		accountTags, err := keyvaluetags.OrganizationsListTags(meta.(*AWSClient).organizationsconn, meta.(*AWSClient).accountid)
+
+		switch {
+		case tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccessDeniedException):
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unable to inherit the tags of AWS account (%s)", meta.(*AWSClient).accountid),
+				Detail:   fmt.Sprintf("The member is created without the account tags. Listing the tags of an account requires the AWS Organizations management account or a delegated administrator: %s", err),
+			})
+		case err != nil:
+			return nil, fmt.Errorf("error listing tags for AWS account (%s): %w", meta.(*AWSClient).accountid, err)
+		default:
+			accountTags = accountTags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+			if err := d.Set("inherited_tags", accountTags.Map()); err != nil {
+				return nil, fmt.Errorf("error setting `%s`: %w", "inherited_tags", err)
+			}
+
+			// Provider default tags and resource tags take precedence over the account tags.
+			tags = accountTags.Merge(tags)
+		}
+	}
+
+	accountId := d.Get("account_id").(string)
+	input := &macie2.CreateMemberInput{
+		Account: &macie2.AccountDetail{
+			AccountId: aws.String(accountId),
+			Email:     aws.String(macie2MemberNormalizeEmail(d.Get("email").(string))),
+		},
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Macie2Tags()
+	}
+
+	// CreateMember has no client token, so before each retry the member is looked up
+	// and a member created by a previous attempt is kept instead of being created again.
+	var retry bool
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		if retry {
+			exists, err := macie2MemberRecordExists(ctx, conn, accountId)
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if exists {
+				return nil
+			}
+		}
+
+		retry = true
+
+		_, err := conn.CreateMemberWithContext(ctx, input)
+
+		if tfmacie2.IsMemberCreationError(err) || tfmacie2.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		var exists bool
+
+		if exists, err = macie2MemberRecordExists(ctx, conn, accountId); err == nil && !exists {
+			_, err = conn.CreateMemberWithContext(ctx, input)
+		}
+	}
+
+	return warnings, err
+}
+
+// macie2MemberRecordExists returns whether the member account record exists and has not been removed.
+func macie2MemberRecordExists(ctx context.Context, conn *macie2.Macie2, accountID string) (bool, error) {
+	output, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{
+		Id: aws.String(accountID),
+	})
+
+	if tfmacie2.IsNotFoundError(err) || isMacie2MemberNotAssociatedError(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return output != nil && aws.StringValue(output.RelationshipStatus) != macie2.RelationshipStatusRemoved, nil
 }
 
 func resourceMacie2MemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -194,17 +573,48 @@ func resourceMacie2MemberRead(ctx context.Context, d *schema.ResourceData, meta
 		Id: aws.String(d.Id()),
 	}
 
-	resp, err := conn.GetMemberWithContext(ctx, input)
+	// Administrator accounts with many members are throttled when all the members are refreshed.
+	var resp *macie2.GetMemberOutput
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		var err error
+		resp, err = conn.GetMemberWithContext(ctx, input)
+
+		if tfmacie2.IsThrottlingError(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		resp, err = conn.GetMemberWithContext(ctx, input)
+	}
 	log.Printf("[INFO] Macie member response: %+v", resp)
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeConflictException, "member accounts are associated with your account") ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeValidationException, "account is not associated with your account") {
+		// In strict mode, only a member that does not exist is removed from state.
+		if d.Get("strict").(bool) && !tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+			return diag.FromErr(fmt.Errorf("error reading Macie Member (%s): %w", d.Id(), err))
+		}
+
+		if tfmacie2.IsNotFoundError(err) {
 			log.Printf("[WARN] Macie Member (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
+
+		// The member account record still exists but is no longer associated with the administrator account,
+		// so it is kept in state as removed and the invitation is sent again on the next apply.
+		if isMacie2MemberNotAssociatedError(err) {
+			log.Printf("[WARN] Macie Member (%s) not associated, setting relationship status to %s", d.Id(), macie2.RelationshipStatusRemoved)
+			d.Set("relationship_status", macie2.RelationshipStatusRemoved)
+			d.Set("invite", false)
+			return nil
+		}
+
 		return diag.FromErr(fmt.Errorf("error reading Macie Member (%s): %w", d.Id(), err))
 	}
 
@@ -213,12 +623,28 @@ func resourceMacie2MemberRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("relationship_status", resp.RelationshipStatus)
 	d.Set("administrator_account_id", resp.AdministratorAccountId)
 	d.Set("master_account_id", resp.MasterAccountId)
-	d.Set("invited_at", aws.TimeValue(resp.InvitedAt).Format(time.RFC3339))
-	d.Set("updated_at", aws.TimeValue(resp.UpdatedAt).Format(time.RFC3339))
+	// A member that was never invited has no invitation timestamp.
+	if resp.InvitedAt != nil {
+		d.Set("invited_at", aws.TimeValue(resp.InvitedAt).UTC().Format(time.RFC3339))
+	} else {
+		d.Set("invited_at", nil)
+	}
+
+	d.Set("invitation_age_days", macie2MemberInvitationAgeDays(resp.InvitedAt, time.Now()))
+	d.Set("organization_managed", macie2MemberOrganizationManaged(resp))
+	d.Set("updated_at", aws.TimeValue(resp.UpdatedAt).UTC().Format(time.RFC3339))
 	d.Set("arn", resp.Arn)
 	tags := keyvaluetags.Macie2KeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
 
-	if err = d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	// Tags inherited from the administrator account are only reported in tags_all, unless they are also configured.
+	inheritedTags := keyvaluetags.New(d.Get("inherited_tags").(map[string]interface{}))
+	configuredTags := keyvaluetags.New(d.Get("tags").(map[string]interface{}))
+
+	if err = d.Set("inherited_tags", inheritedTags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie Member (%s): %w", "inherited_tags", d.Id(), err))
+	}
+
+	if err = d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Ignore(inheritedTags.Ignore(configuredTags)).Map()); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie Member (%s): %w", "tags", d.Id(), err))
 	}
 
@@ -226,43 +652,209 @@ func resourceMacie2MemberRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie Member (%s): %w", "tags_all", d.Id(), err))
 	}
 
-	status := aws.StringValue(resp.RelationshipStatus)
-	log.Printf("[DEBUG] print resp.RelationshipStatus: %v", aws.StringValue(resp.RelationshipStatus))
-	if status == macie2.RelationshipStatusEnabled ||
-		status == macie2.RelationshipStatusInvited || status == macie2.RelationshipStatusEmailVerificationInProgress ||
-		status == macie2.RelationshipStatusPaused {
-		d.Set("invite", true)
+	// Arguments without a remote value are defaulted so that imported members never show a plan difference.
+	onInviteFalse := d.Get("on_invite_false").(string)
+	if onInviteFalse == "" {
+		onInviteFalse = tfmacie2.MemberOnInviteFalseDisassociate
+		d.Set("on_invite_false", onInviteFalse)
+	}
+
+	d.Set("inherit_account_tags", d.Get("inherit_account_tags").(bool))
+
+	// The schema default is not applied on import, where the argument is absent from the state.
+	if _, ok := d.GetOkExists("fail_on_unprocessed"); !ok {
+		d.Set("fail_on_unprocessed", true)
+	}
+
+	d.Set("strict", d.Get("strict").(bool))
+
+	relationshipStatus := aws.StringValue(resp.RelationshipStatus)
+
+	d.Set("invite", macie2MemberInvite(relationshipStatus, d.Get("invite").(bool), onInviteFalse))
+
+	d.Set("status", macie2MemberStatus(relationshipStatus, d.Get("status").(string)))
+
+	// Member accounts cannot change their finding publishing frequency, associated members use the one of the administrator account.
+	// It is only read when the member is created or updated, or first reported as associated, so that refreshing
+	// the many members of an administrator account does not call GetMacieSession for each of them.
+	administratorFrequency := d.Get("finding_publishing_frequency").(string)
+	if tfmacie2.MemberState(relationshipStatus) == tfmacie2.MemberStateAssociated && (d.IsNewResource() || administratorFrequency == "") {
+		session, err := conn.GetMacieSessionWithContext(ctx, &macie2.GetMacieSessionInput{})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Macie Member (%s) finding publishing frequency: %w", d.Id(), err))
+		}
+
+		administratorFrequency = aws.StringValue(session.FindingPublishingFrequency)
+	}
+
+	d.Set("finding_publishing_frequency", macie2MemberFindingPublishingFrequency(relationshipStatus, administratorFrequency))
+
+	return macie2MemberAdministratorDiagnostics(d.Id(), meta.(*AWSClient).accountid, resp)
+}
+
+// macie2MemberStatus returns the status of the member. The status of an associated member is its session status,
+// so that a member paused or enabled out-of-band is corrected on the next apply. A member that is not associated
+// has no session, its configured status is kept until it is associated, or ENABLED when there is none, e.g. on import.
+func macie2MemberStatus(relationshipStatus, configuredStatus string) string {
+	switch relationshipStatus {
+	case macie2.RelationshipStatusEnabled:
+		return macie2.MacieStatusEnabled
+	case macie2.RelationshipStatusPaused:
+		return macie2.MacieStatusPaused
 	}
 
-	if status == macie2.RelationshipStatusRemoved {
-		d.Set("invite", false)
+	if configuredStatus == "" {
+		return macie2.MacieStatusEnabled
 	}
 
-	// To fake a result for status in order to avoid an error related to difference for ImportVerifyState
-	// It sets to MacieStatusPaused because it can only be changed to PAUSED, normally when it's accepted its status is ENABLED
-	status = macie2.MacieStatusEnabled
-	if aws.StringValue(resp.RelationshipStatus) == macie2.RelationshipStatusPaused {
-		status = macie2.MacieStatusPaused
+	return configuredStatus
+}
+
+// macie2MemberFindingPublishingFrequency returns the finding publishing frequency the member inherits from the administrator account.
+// A member that has not accepted the invitation, or is no longer associated, inherits no frequency.
+func macie2MemberFindingPublishingFrequency(relationshipStatus, administratorFrequency string) string {
+	if tfmacie2.MemberState(relationshipStatus) != tfmacie2.MemberStateAssociated {
+		return ""
 	}
-	d.Set("status", status)
 
-	return nil
+	return administratorFrequency
+}
+
+// macie2MemberInvitationAgeDays returns the number of full days elapsed since the member was last invited.
+// It returns 0 for a member that was never invited, or whose invitation timestamp is ahead of the local clock.
+func macie2MemberInvitationAgeDays(invitedAt *time.Time, now time.Time) int {
+	if invitedAt == nil || invitedAt.IsZero() {
+		return 0
+	}
+
+	age := now.Sub(*invitedAt)
+
+	if age < 0 {
+		return 0
+	}
+
+	return int(age / (24 * time.Hour))
+}
+
+// macie2MemberOrganizationManaged returns whether the member was enabled through the organization rather than invited.
+// Organization members are associated with the administrator account without ever being sent an invitation.
+func macie2MemberOrganizationManaged(member *macie2.GetMemberOutput) bool {
+	if member == nil || member.InvitedAt != nil {
+		return false
+	}
+
+	return tfmacie2.MemberState(aws.StringValue(member.RelationshipStatus)) == tfmacie2.MemberStateAssociated
+}
+
+// isMacie2MemberNotAssociatedError returns whether the error indicates that the member account exists
+// but is no longer associated with the administrator account.
+// The ConflictException returned for associated members is the opposite case, see tfmacie2.IsMemberAssociatedError.
+func isMacie2MemberNotAssociatedError(err error) bool {
+	return tfawserr.ErrMessageContains(err, macie2.ErrCodeValidationException, "account is not associated with your account")
+}
+
+// macie2MemberInvite returns the value of the `invite` argument matching the relationship status of the member.
+// Members created through the organization have an ENABLED relationship status and are reported as invited.
+func macie2MemberInvite(relationshipStatus string, invite bool, onInviteFalse string) bool {
+	switch tfmacie2.MemberState(relationshipStatus) {
+	case tfmacie2.MemberStateAssociated:
+		// A member paused because `invite` was set to false keeps its relationship with the administrator account.
+		if relationshipStatus == macie2.RelationshipStatusPaused && onInviteFalse == tfmacie2.MemberOnInviteFalsePause && !invite {
+			return false
+		}
+
+		return true
+	case tfmacie2.MemberStateInvited:
+		return true
+	case tfmacie2.MemberStateNotAssociated:
+		return false
+	default:
+		return invite
+	}
+}
+
+// macie2MemberAdministratorDiagnostics returns warnings when the member appears to be managed by an administrator
+// account other than the one running Terraform, which usually indicates a misconfigured multi-administrator setup.
+func macie2MemberAdministratorDiagnostics(id, callerAccountID string, member *macie2.GetMemberOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	administratorAccountID := aws.StringValue(member.AdministratorAccountId)
+	masterAccountID := aws.StringValue(member.MasterAccountId)
+
+	if administratorAccountID != "" && administratorAccountID != callerAccountID {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Macie Member (%s) has a different administrator account", id),
+			Detail:   fmt.Sprintf("The administrator account of the member (%s) is not the account running Terraform (%s). Verify that the member is managed from the correct Macie administrator account.", administratorAccountID, callerAccountID),
+		})
+	}
+
+	if administratorAccountID != "" && masterAccountID != "" && administratorAccountID != masterAccountID {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Macie Member (%s) has inconsistent administrator accounts", id),
+			Detail:   fmt.Sprintf("The administrator account (%s) and master account (%s) of the member differ.", administratorAccountID, masterAccountID),
+		})
+	}
+
+	return diags
 }
 
 func resourceMacie2MemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).macie2conn
 
+	// Warnings about invitations that could not be processed are returned with the refreshed state.
+	var warnings diag.Diagnostics
+
 	// Invitation workflow
 
 	if d.HasChange("invite") {
-		if d.Get("invite").(bool) {
+		if d.Get("invite").(bool) && d.Get("relationship_status").(string) == macie2.RelationshipStatusPaused {
+			// The member was paused when `invite` was set to false, resume its session instead of inviting it again.
+			input := &macie2.UpdateMemberSessionInput{
+				Id:     aws.String(d.Id()),
+				Status: aws.String(macie2.MacieStatusEnabled),
+			}
+
+			mutexKey := macie2MemberSessionMutexKey(d.Id())
+			awsMutexKV.Lock(mutexKey)
+			_, err := conn.UpdateMemberSessionWithContext(ctx, input)
+			awsMutexKV.Unlock(mutexKey)
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error resuming Macie Member (%s) session: %w", d.Id(), err))
+			}
+		} else if d.Get("invite").(bool) {
+			if diags := macie2MemberInvitationResendGuard(d.Id(), d.Get("relationship_status").(string), d.Get("last_invited_at").(string), time.Now()); diags.HasError() {
+				return diags
+			}
+
+			// A disassociated member keeps a record in the REMOVED state which cannot be invited again,
+			// so the member record is re-created before sending the invitation.
+			if d.Get("relationship_status").(string) == macie2.RelationshipStatusRemoved {
+				_, err := conn.DeleteMemberWithContext(ctx, &macie2.DeleteMemberInput{
+					Id: aws.String(d.Id()),
+				})
+
+				if err != nil && !tfmacie2.IsNotFoundError(err) {
+					return diag.FromErr(fmt.Errorf("error deleting removed Macie Member (%s): %w", d.Id(), err))
+				}
+
+				recordWarnings, err := macie2MemberCreateRecord(ctx, conn, d, meta, d.Timeout(schema.TimeoutUpdate))
+
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error re-creating Macie Member (%s): %w", d.Id(), err))
+				}
+
+				warnings = append(warnings, recordWarnings...)
+			}
+
 			inputInvite := &macie2.CreateInvitationsInput{
 				AccountIds: []*string{aws.String(d.Id())},
 			}
 
-			if v, ok := d.GetOk("invitation_disable_email_notification"); ok {
-				inputInvite.DisableEmailNotification = aws.Bool(v.(bool))
-			}
+			inputInvite.DisableEmailNotification = macie2MemberDisableEmailNotification(d, meta)
 			if v, ok := d.GetOk("invitation_message"); ok {
 				inputInvite.Message = aws.String(v.(string))
 			}
@@ -270,10 +862,10 @@ func resourceMacie2MemberUpdate(ctx context.Context, d *schema.ResourceData, met
 			log.Printf("[INFO] Inviting Macie2 Member: %s", inputInvite)
 			var output *macie2.CreateInvitationsOutput
 			var err error
-			err = resource.RetryContext(ctx, 4*time.Minute, func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				output, err = conn.CreateInvitationsWithContext(ctx, inputInvite)
 
-				if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
+				if tfmacie2.IsMemberCreationError(err) || tfmacie2.IsThrottlingError(err) {
 					return resource.RetryableError(err)
 				}
 
@@ -292,12 +884,38 @@ func resourceMacie2MemberUpdate(ctx context.Context, d *schema.ResourceData, met
 				return diag.FromErr(fmt.Errorf("error inviting Macie Member: %w", err))
 			}
 
-			if len(output.UnprocessedAccounts) != 0 {
-				return diag.FromErr(fmt.Errorf("error inviting Macie Member: %s: %s", aws.StringValue(output.UnprocessedAccounts[0].ErrorCode), aws.StringValue(output.UnprocessedAccounts[0].ErrorMessage)))
+			unprocessedDiags := macie2MemberUnprocessedAccountDiagnostics(d.Id(), output.UnprocessedAccounts, d.Get("fail_on_unprocessed").(bool))
+
+			if unprocessedDiags.HasError() {
+				return unprocessedDiags
 			}
 
-			if _, err = waiter.MemberInvited(ctx, conn, d.Id()); err != nil {
-				return diag.FromErr(fmt.Errorf("error waiting for Macie Member (%s) invitation: %w", d.Id(), err))
+			warnings = append(warnings, unprocessedDiags...)
+
+			if unprocessedDiags == nil {
+				d.Set("invitation_message_hash", macie2MemberInvitationMessageHash(aws.StringValue(inputInvite.Message)))
+				d.Set("last_invited_at", time.Now().UTC().Format(time.RFC3339))
+
+				if _, err = waiter.MemberInvited(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.FromErr(fmt.Errorf("error waiting for Macie Member (%s) invitation: %w", d.Id(), err))
+				}
+			}
+		} else if d.Get("on_invite_false").(string) == tfmacie2.MemberOnInviteFalsePause {
+			input := &macie2.UpdateMemberSessionInput{
+				Id:     aws.String(d.Id()),
+				Status: aws.String(macie2.MacieStatusPaused),
+			}
+
+			mutexKey := macie2MemberSessionMutexKey(d.Id())
+			awsMutexKV.Lock(mutexKey)
+			_, err := conn.UpdateMemberSessionWithContext(ctx, input)
+			awsMutexKV.Unlock(mutexKey)
+
+			if err != nil {
+				if tfmacie2.IsNotFoundError(err) {
+					return resourceMacie2MemberRead(ctx, d, meta)
+				}
+				return diag.FromErr(fmt.Errorf("error pausing Macie Member (%s) session: %w", d.Id(), err))
 			}
 		} else {
 			input := &macie2.DisassociateMemberInput{
@@ -306,31 +924,82 @@ func resourceMacie2MemberUpdate(ctx context.Context, d *schema.ResourceData, met
 
 			_, err := conn.DisassociateMemberWithContext(ctx, input)
 			if err != nil {
-				if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-					tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
-					return nil
+				if tfmacie2.IsNotFoundError(err) {
+					return resourceMacie2MemberRead(ctx, d, meta)
 				}
 				return diag.FromErr(fmt.Errorf("error disassociating Macie Member invite (%s): %w", d.Id(), err))
 			}
+
+			// The disassociation is confirmed so that the refreshed state reports the member as removed.
+			_, err = waiter.MemberDisassociated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil && !isMacie2MemberNotAssociatedError(err) {
+				return diag.FromErr(fmt.Errorf("error waiting for Macie Member (%s) disassociation: %w", d.Id(), err))
+			}
 		}
 	}
 
 	// End Invitation workflow
 
-	if d.HasChange("status") {
-		input := &macie2.UpdateMemberSessionInput{
-			Id:     aws.String(d.Id()),
-			Status: aws.String(d.Get("status").(string)),
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie Member (%s) tags: %w", d.Id(), err))
 		}
+	}
 
-		_, err := conn.UpdateMemberSessionWithContext(ctx, input)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("error updating Macie Member (%s): %w", d.Id(), err))
+	if d.HasChange("status") {
+		if err := macie2MemberApplyStatus(ctx, conn, d); err != nil {
+			return diag.FromErr(err)
 		}
+	}
+
+	// The finding publishing frequency of the administrator account is read again by the refresh.
+	d.Set("finding_publishing_frequency", "")
+
+	return append(warnings, resourceMacie2MemberRead(ctx, d, meta)...)
+}
+
+// macie2MemberInvitationMessageHash returns the hex encoded SHA256 hash of the invitation message,
+// which records the message sent to the member account without storing it.
+func macie2MemberInvitationMessageHash(message string) string {
+	if message == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(message))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// macie2MemberInvitationResendGuard refuses to send another invitation to a member account whose invitation is still pending
+// when the resource sent the previous one, recorded in `last_invited_at`, less than macie2MemberInvitationResendInterval ago.
+// This prevents a configuration loop that keeps flipping `invite` from spamming the external account,
+// while a member that was disassociated can be invited again right away.
+func macie2MemberInvitationResendGuard(id, relationshipStatus, lastInvitedAt string, now time.Time) diag.Diagnostics {
+	if relationshipStatus != macie2.RelationshipStatusInvited {
+		return nil
+	}
+
+	invitedAt, err := time.Parse(time.RFC3339, lastInvitedAt)
+
+	if err != nil {
+		return nil
+	}
 
+	if elapsed := now.Sub(invitedAt); elapsed < macie2MemberInvitationResendInterval {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Macie Member (%s) invitation was sent too recently", id),
+				Detail: fmt.Sprintf("An invitation was sent to the member account at %s. Invitations can be resent at most once every %s, retry after %s.",
+					invitedAt.Format(time.RFC3339), macie2MemberInvitationResendInterval, invitedAt.Add(macie2MemberInvitationResendInterval).Format(time.RFC3339)),
+			},
+		}
 	}
 
-	return resourceMacie2MemberRead(ctx, d, meta)
+	return nil
 }
 
 func resourceMacie2MemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -341,14 +1010,45 @@ func resourceMacie2MemberDelete(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	_, err := conn.DeleteMemberWithContext(ctx, input)
-	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeConflictException, "member accounts are associated with your account") ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeValidationException, "account is not associated with your account") {
+
+	// An associated member must be disassociated before it can be deleted.
+	if tfmacie2.IsMemberAssociatedError(err) {
+		_, err = conn.DisassociateMemberWithContext(ctx, &macie2.DisassociateMemberInput{
+			Id: aws.String(d.Id()),
+		})
+
+		if err != nil && !tfmacie2.IsNotFoundError(err) {
+			return diag.FromErr(fmt.Errorf("error disassociating Macie Member (%s): %w", d.Id(), err))
+		}
+
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+			_, err := conn.DeleteMemberWithContext(ctx, input)
+
+			if tfmacie2.IsMemberAssociatedError(err) || tfmacie2.IsThrottlingError(err) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
 			return nil
+		})
+
+		if isResourceTimeoutError(err) {
+			_, err = conn.DeleteMemberWithContext(ctx, input)
 		}
+	}
+
+	if tfmacie2.IsNotFoundError(err) || isMacie2MemberNotAssociatedError(err) {
+		return nil
+	}
+
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting Macie Member (%s): %w", d.Id(), err))
 	}
+
+	macie2MemberRemovals.Store(d.Id(), time.Now())
+
 	return nil
 }
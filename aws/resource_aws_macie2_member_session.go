@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+)
+
+func resourceAwsMacie2MemberSession() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMacie2MemberSessionCreate,
+		ReadWithoutTimeout:   resourceMacie2MemberSessionRead,
+		UpdateWithoutTimeout: resourceMacie2MemberSessionUpdate,
+		DeleteWithoutTimeout: resourceMacie2MemberSessionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.MacieStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceMacie2MemberSessionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accountID := d.Get("account_id").(string)
+
+	if err := macie2MemberSessionApply(ctx, meta.(*AWSClient).macie2conn, accountID, d.Get("status").(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Macie Member Session (%s): %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceMacie2MemberSessionRead(ctx, d, meta)
+}
+
+func resourceMacie2MemberSessionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+
+	resp, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfmacie2.IsNotFoundError(err) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeValidationException, "account is not associated with your account") {
+		log.Printf("[WARN] Macie Member (%s) not found, removing Member Session from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie Member Session (%s): %w", d.Id(), err))
+	}
+
+	relationshipStatus := aws.StringValue(resp.RelationshipStatus)
+
+	d.Set("account_id", resp.AccountId)
+	d.Set("relationship_status", relationshipStatus)
+
+	// Only an enabled or paused relationship reflects the session status, any other relationship status is left as drift for Update to report.
+	switch relationshipStatus {
+	case macie2.RelationshipStatusEnabled:
+		d.Set("status", macie2.MacieStatusEnabled)
+	case macie2.RelationshipStatusPaused:
+		d.Set("status", macie2.MacieStatusPaused)
+	}
+
+	return nil
+}
+
+func resourceMacie2MemberSessionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := macie2MemberSessionApply(ctx, meta.(*AWSClient).macie2conn, d.Id(), d.Get("status").(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Macie Member Session (%s): %w", d.Id(), err))
+	}
+
+	return resourceMacie2MemberSessionRead(ctx, d, meta)
+}
+
+func resourceMacie2MemberSessionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The member session is left in its current status.
+	log.Printf("[WARN] Macie Member Session (%s) removed from state, member session status is unchanged", d.Id())
+
+	return nil
+}
+
+// macie2MemberSessionApply updates the session of the member when its status differs from the specified status.
+// Session updates of a member are serialized so that the aws_macie2_member, aws_macie2_member_session and
+// aws_macie2_members_session resources never update the same member concurrently.
+func macie2MemberSessionApply(ctx context.Context, conn *macie2.Macie2, accountID, status string) error {
+	mutexKey := macie2MemberSessionMutexKey(accountID)
+	awsMutexKV.Lock(mutexKey)
+	defer awsMutexKV.Unlock(mutexKey)
+
+	resp, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{
+		Id: aws.String(accountID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Member (%s): %w", accountID, err)
+	}
+
+	switch relationshipStatus := aws.StringValue(resp.RelationshipStatus); relationshipStatus {
+	case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused:
+		if strings.EqualFold(relationshipStatus, status) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("member must have a relationship status of %s or %s to update its session, got %s", macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused, relationshipStatus)
+	}
+
+	input := &macie2.UpdateMemberSessionInput{
+		Id:     aws.String(accountID),
+		Status: aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Macie Member (%s) session: %s", accountID, input)
+	if _, err := conn.UpdateMemberSessionWithContext(ctx, input); err != nil {
+		return fmt.Errorf("error updating Macie Member (%s) session: %w", accountID, err)
+	}
+
+	return nil
+}
+
+func macie2MemberSessionMutexKey(accountID string) string {
+	return fmt.Sprintf("macie2-member-session-%s", accountID)
+}
@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+func testAccAwsMacie2MemberSession_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_macie2_member_session.test"
+	dataSourceAlternate := "data.aws_caller_identity.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2AlternateEmail, EnvVarMacie2AlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2InvitationAccepterDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMemberSessionConfig(email, macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceAlternate, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusPaused),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberSessionConfig(email, macie2.MacieStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusEnabled),
+				),
+			},
+			{
+				Config:            testAccAwsMacieMemberSessionConfig(email, macie2.MacieStatusEnabled),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacieMemberSessionConfig(email, status string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "admin" {}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_account" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_member" "member" {
+  account_id         = data.aws_caller_identity.member.account_id
+  email              = %[1]q
+  invite             = true
+  invitation_message = "This is a message of the invitation"
+  depends_on         = [aws_macie2_account.admin]
+}
+
+resource "aws_macie2_invitation_accepter" "member" {
+  provider                 = "awsalternate"
+  administrator_account_id = data.aws_caller_identity.admin.account_id
+  depends_on               = [aws_macie2_member.member]
+}
+
+resource "aws_macie2_member_session" "test" {
+  account_id = aws_macie2_member.member.account_id
+  status     = %[2]q
+  depends_on = [aws_macie2_invitation_accepter.member]
+}
+`, email, status)
+}
@@ -2,15 +2,20 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
 )
 
 const (
@@ -22,6 +27,570 @@ const (
 		"To properly test inviting Macie member account must be provided."
 )
 
+func TestMacie2MemberAdministratorDiagnostics(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Administrator string
+		Master        string
+		ExpectedCount int
+	}{
+		{
+			Name:          "no administrator",
+			ExpectedCount: 0,
+		},
+		{
+			Name:          "caller is administrator",
+			Administrator: "123456789012",
+			Master:        "123456789012",
+			ExpectedCount: 0,
+		},
+		{
+			Name:          "other administrator",
+			Administrator: "210987654321",
+			Master:        "210987654321",
+			ExpectedCount: 1,
+		},
+		{
+			Name:          "inconsistent administrator and master",
+			Administrator: "123456789012",
+			Master:        "210987654321",
+			ExpectedCount: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			member := &macie2.GetMemberOutput{}
+
+			if testCase.Administrator != "" {
+				member.AdministratorAccountId = aws.String(testCase.Administrator)
+			}
+
+			if testCase.Master != "" {
+				member.MasterAccountId = aws.String(testCase.Master)
+			}
+
+			diags := macie2MemberAdministratorDiagnostics("111111111111", "123456789012", member)
+
+			if got := len(diags); got != testCase.ExpectedCount {
+				t.Fatalf("expected %d diagnostics, got %d: %v", testCase.ExpectedCount, got, diags)
+			}
+
+			if diags.HasError() {
+				t.Errorf("expected only warnings, got: %v", diags)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberReadErrors(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		Err                   error
+		ExpectedNotFound      bool
+		ExpectedNotAssociated bool
+	}{
+		{
+			Name:             "resource not found",
+			Err:              awserr.New(macie2.ErrCodeResourceNotFoundException, "The request failed because the specified resource wasn't found", nil),
+			ExpectedNotFound: true,
+		},
+		{
+			Name:             "Macie not enabled",
+			Err:              awserr.New(macie2.ErrCodeAccessDeniedException, "Macie is not enabled", nil),
+			ExpectedNotFound: true,
+		},
+		{
+			Name:                  "not associated",
+			Err:                   awserr.New(macie2.ErrCodeValidationException, "The request failed because the account is not associated with your account", nil),
+			ExpectedNotAssociated: true,
+		},
+		{
+			Name: "member accounts associated",
+			Err:  awserr.New(macie2.ErrCodeConflictException, "The request failed because member accounts are associated with your account", nil),
+		},
+		{
+			Name: "other error",
+			Err:  awserr.New(macie2.ErrCodeInternalServerException, "Internal error", nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := tfmacie2.IsNotFoundError(testCase.Err); got != testCase.ExpectedNotFound {
+				t.Errorf("expected not found %t, got %t", testCase.ExpectedNotFound, got)
+			}
+
+			if got := isMacie2MemberNotAssociatedError(testCase.Err); got != testCase.ExpectedNotAssociated {
+				t.Errorf("expected not associated %t, got %t", testCase.ExpectedNotAssociated, got)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberNormalizeEmail(t *testing.T) {
+	testCases := []struct {
+		Email    string
+		Expected string
+	}{
+		{
+			Email:    "required@example.com",
+			Expected: "required@example.com",
+		},
+		{
+			Email:    "Required@Example.COM",
+			Expected: "required@example.com",
+		},
+		{
+			Email:    " required@example.com\n",
+			Expected: "required@example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Email, func(t *testing.T) {
+			if got := macie2MemberNormalizeEmail(testCase.Email); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberDisableEmailNotification(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Raw              map[string]interface{}
+		ProviderDisabled bool
+		Expected         *bool
+	}{
+		{
+			Name: "not set",
+			Raw:  map[string]interface{}{"invite": true},
+		},
+		{
+			Name:     "resource disabled",
+			Raw:      map[string]interface{}{"invite": true, "invitation_disable_email_notification": true},
+			Expected: aws.Bool(true),
+		},
+		{
+			Name:             "not set provider disabled",
+			Raw:              map[string]interface{}{"invite": true},
+			ProviderDisabled: true,
+			Expected:         aws.Bool(true),
+		},
+		{
+			Name:             "resource enabled provider disabled",
+			Raw:              map[string]interface{}{"invite": true, "invitation_disable_email_notification": false},
+			ProviderDisabled: true,
+			Expected:         aws.Bool(false),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceAwsMacie2Member().Schema, testCase.Raw)
+			meta := &AWSClient{DisableInvitationEmailNotifications: testCase.ProviderDisabled}
+
+			if got := macie2MemberDisableEmailNotification(d, meta); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", aws.BoolValue(got), aws.BoolValue(testCase.Expected))
+			}
+		})
+	}
+}
+
+func TestMacie2MemberUnprocessedAccountDiagnostics(t *testing.T) {
+	unprocessedAccounts := []*macie2.UnprocessedAccount{
+		{
+			AccountId:    aws.String("111111111111"),
+			ErrorCode:    aws.String(macie2.ErrorCodeClientError),
+			ErrorMessage: aws.String("The account is already a member"),
+		},
+	}
+
+	testCases := []struct {
+		Name                string
+		UnprocessedAccounts []*macie2.UnprocessedAccount
+		FailOnUnprocessed   bool
+		ExpectedCount       int
+		ExpectedError       bool
+		ExpectedDetail      string
+	}{
+		{
+			Name:              "processed",
+			FailOnUnprocessed: true,
+			ExpectedCount:     0,
+		},
+		{
+			Name:                "unprocessed fails",
+			UnprocessedAccounts: unprocessedAccounts,
+			FailOnUnprocessed:   true,
+			ExpectedCount:       1,
+			ExpectedError:       true,
+			ExpectedDetail:      "error inviting Macie Member: ClientError: The account is already a member: the account is already a member of an administrator account, disassociate it from its current administrator account or import the existing member",
+		},
+		{
+			Name:                "unprocessed warns",
+			UnprocessedAccounts: unprocessedAccounts,
+			FailOnUnprocessed:   false,
+			ExpectedCount:       1,
+			ExpectedError:       false,
+			ExpectedDetail:      "error inviting Macie Member: ClientError: The account is already a member: the account is already a member of an administrator account, disassociate it from its current administrator account or import the existing member",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := macie2MemberUnprocessedAccountDiagnostics("111111111111", testCase.UnprocessedAccounts, testCase.FailOnUnprocessed)
+
+			if got := len(diags); got != testCase.ExpectedCount {
+				t.Fatalf("expected %d diagnostics, got %d: %v", testCase.ExpectedCount, got, diags)
+			}
+
+			if got := diags.HasError(); got != testCase.ExpectedError {
+				t.Errorf("expected error %t, got %t: %v", testCase.ExpectedError, got, diags)
+			}
+
+			if testCase.ExpectedDetail == "" {
+				return
+			}
+
+			// diag.FromErr sets the error in the summary, the warning sets it in the detail.
+			got := diags[0].Detail
+			if diags.HasError() {
+				got = diags[0].Summary
+			}
+
+			if got != testCase.ExpectedDetail {
+				t.Errorf("got %q, expected %q", got, testCase.ExpectedDetail)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberStatus(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		RelationshipStatus string
+		ConfiguredStatus   string
+		Expected           string
+	}{
+		{
+			Name:               "enabled",
+			RelationshipStatus: macie2.RelationshipStatusEnabled,
+			ConfiguredStatus:   macie2.MacieStatusPaused,
+			Expected:           macie2.MacieStatusEnabled,
+		},
+		{
+			Name:               "paused",
+			RelationshipStatus: macie2.RelationshipStatusPaused,
+			ConfiguredStatus:   macie2.MacieStatusEnabled,
+			Expected:           macie2.MacieStatusPaused,
+		},
+		{
+			Name:               "invited keeps configured status",
+			RelationshipStatus: macie2.RelationshipStatusInvited,
+			ConfiguredStatus:   macie2.MacieStatusPaused,
+			Expected:           macie2.MacieStatusPaused,
+		},
+		{
+			Name:               "removed keeps configured status",
+			RelationshipStatus: macie2.RelationshipStatusRemoved,
+			ConfiguredStatus:   macie2.MacieStatusPaused,
+			Expected:           macie2.MacieStatusPaused,
+		},
+		{
+			Name:               "created without configured status",
+			RelationshipStatus: macie2.RelationshipStatusCreated,
+			Expected:           macie2.MacieStatusEnabled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := macie2MemberStatus(testCase.RelationshipStatus, testCase.ConfiguredStatus); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberInvite(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		RelationshipStatus string
+		Invite             bool
+		OnInviteFalse      string
+		Expected           bool
+	}{
+		{
+			Name:               "created",
+			RelationshipStatus: macie2.RelationshipStatusCreated,
+			Invite:             true,
+			OnInviteFalse:      "disassociate",
+			Expected:           false,
+		},
+		{
+			Name:               "invited",
+			RelationshipStatus: macie2.RelationshipStatusInvited,
+			OnInviteFalse:      "disassociate",
+			Expected:           true,
+		},
+		{
+			Name:               "enabled",
+			RelationshipStatus: macie2.RelationshipStatusEnabled,
+			OnInviteFalse:      "disassociate",
+			Expected:           true,
+		},
+		{
+			Name:               "organization managed",
+			RelationshipStatus: macie2.RelationshipStatusEnabled,
+			Expected:           true,
+		},
+		{
+			Name:               "paused",
+			RelationshipStatus: macie2.RelationshipStatusPaused,
+			OnInviteFalse:      "disassociate",
+			Expected:           true,
+		},
+		{
+			Name:               "paused by invite false",
+			RelationshipStatus: macie2.RelationshipStatusPaused,
+			OnInviteFalse:      "pause",
+			Expected:           false,
+		},
+		{
+			Name:               "removed",
+			RelationshipStatus: macie2.RelationshipStatusRemoved,
+			Invite:             true,
+			OnInviteFalse:      "disassociate",
+			Expected:           false,
+		},
+		{
+			Name:               "account suspended",
+			RelationshipStatus: macie2.RelationshipStatusAccountSuspended,
+			Invite:             true,
+			OnInviteFalse:      "disassociate",
+			Expected:           true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := macie2MemberInvite(testCase.RelationshipStatus, testCase.Invite, testCase.OnInviteFalse)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberInvitationMessageHash(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Message  string
+		Expected string
+	}{
+		{
+			Name: "no message",
+		},
+		{
+			Name:     "message",
+			Message:  "This is a message of the invitation",
+			Expected: "ef8e26cdb3aa75d36fdeab38c475e4ff93f5461ecdef4cadce9e1d780c2917d5",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := macie2MemberInvitationMessageHash(testCase.Message); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberInvitationResendGuard(t *testing.T) {
+	now := time.Date(2021, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name               string
+		RelationshipStatus string
+		LastInvitedAt      string
+		ExpectedError      bool
+	}{
+		{
+			Name:               "never invited by the resource",
+			RelationshipStatus: macie2.RelationshipStatusInvited,
+		},
+		{
+			Name:               "pending invitation sent recently",
+			RelationshipStatus: macie2.RelationshipStatusInvited,
+			LastInvitedAt:      now.Add(-time.Minute).Format(time.RFC3339),
+			ExpectedError:      true,
+		},
+		{
+			Name:               "pending invitation sent before the interval",
+			RelationshipStatus: macie2.RelationshipStatusInvited,
+			LastInvitedAt:      now.Add(-macie2MemberInvitationResendInterval).Format(time.RFC3339),
+		},
+		{
+			Name:               "removed member invited recently",
+			RelationshipStatus: macie2.RelationshipStatusRemoved,
+			LastInvitedAt:      now.Add(-time.Minute).Format(time.RFC3339),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := macie2MemberInvitationResendGuard("123456789012", testCase.RelationshipStatus, testCase.LastInvitedAt, now)
+
+			if got.HasError() != testCase.ExpectedError {
+				t.Errorf("got error %t, expected error %t", got.HasError(), testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberInvitationAgeDays(t *testing.T) {
+	now := time.Date(2021, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name      string
+		InvitedAt *time.Time
+		Expected  int
+	}{
+		{
+			Name:     "never invited",
+			Expected: 0,
+		},
+		{
+			Name:      "zero time",
+			InvitedAt: aws.Time(time.Time{}),
+			Expected:  0,
+		},
+		{
+			Name:      "invited today",
+			InvitedAt: aws.Time(now.Add(-23 * time.Hour)),
+			Expected:  0,
+		},
+		{
+			Name:      "invited one day ago",
+			InvitedAt: aws.Time(now.Add(-24 * time.Hour)),
+			Expected:  1,
+		},
+		{
+			Name:      "invited in another time zone",
+			InvitedAt: aws.Time(time.Date(2021, time.June, 5, 21, 0, 0, 0, time.FixedZone("UTC+10", 10*60*60))),
+			Expected:  10,
+		},
+		{
+			Name:      "clock skew",
+			InvitedAt: aws.Time(now.Add(time.Hour)),
+			Expected:  0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := macie2MemberInvitationAgeDays(testCase.InvitedAt, now)
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberFindingPublishingFrequency(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		RelationshipStatus string
+		Expected           string
+	}{
+		{
+			Name:               "enabled",
+			RelationshipStatus: macie2.RelationshipStatusEnabled,
+			Expected:           macie2.FindingPublishingFrequencyOneHour,
+		},
+		{
+			Name:               "paused",
+			RelationshipStatus: macie2.RelationshipStatusPaused,
+			Expected:           macie2.FindingPublishingFrequencyOneHour,
+		},
+		{
+			Name:               "invited",
+			RelationshipStatus: macie2.RelationshipStatusInvited,
+		},
+		{
+			Name:               "removed",
+			RelationshipStatus: macie2.RelationshipStatusRemoved,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := macie2MemberFindingPublishingFrequency(testCase.RelationshipStatus, macie2.FindingPublishingFrequencyOneHour)
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMacie2MemberOrganizationManaged(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Member   *macie2.GetMemberOutput
+		Expected bool
+	}{
+		{
+			Name:     "nil",
+			Expected: false,
+		},
+		{
+			Name: "invited",
+			Member: &macie2.GetMemberOutput{
+				InvitedAt:          aws.Time(time.Now()),
+				RelationshipStatus: aws.String(macie2.RelationshipStatusEnabled),
+			},
+			Expected: false,
+		},
+		{
+			Name: "organization enabled",
+			Member: &macie2.GetMemberOutput{
+				RelationshipStatus: aws.String(macie2.RelationshipStatusEnabled),
+			},
+			Expected: true,
+		},
+		{
+			Name: "organization paused",
+			Member: &macie2.GetMemberOutput{
+				RelationshipStatus: aws.String(macie2.RelationshipStatusPaused),
+			},
+			Expected: true,
+		},
+		{
+			Name: "created",
+			Member: &macie2.GetMemberOutput{
+				RelationshipStatus: aws.String(macie2.RelationshipStatusCreated),
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := macie2MemberOrganizationManaged(testCase.Member)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func testAccAwsMacie2Member_basic(t *testing.T) {
 	var providers []*schema.Provider
 	var macie2Output macie2.GetMemberOutput
@@ -42,13 +611,17 @@ func testAccAwsMacie2Member_basic(t *testing.T) {
 				Config: testAccAwsMacieMemberConfigBasic(email),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invitation_age_days", "0"),
+					resource.TestMatchResourceAttr(resourceName, "updated_at", regexp.MustCompile(`Z$`)),
 					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusCreated),
 					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
 					testAccCheckResourceAttrAccountID(resourceName, "master_account_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
-					testAccCheckResourceAttrRfc3339(resourceName, "invited_at"),
+					resource.TestCheckResourceAttr(resourceName, "invited_at", ""),
 					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", ""),
 					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_unprocessed", "true"),
 				),
 			},
 			{
@@ -61,6 +634,36 @@ func testAccAwsMacie2Member_basic(t *testing.T) {
 	})
 }
 
+func testAccAwsMacie2Member_emailCase(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.member"
+	email := "Required@Example.COM"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMemberConfigBasic(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "email", "required@example.com"),
+				),
+			},
+			{
+				Config:   testAccAwsMacieMemberConfigBasic(email),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccAwsMacie2Member_disappears(t *testing.T) {
 	var providers []*schema.Provider
 	var macie2Output macie2.GetMemberOutput
@@ -104,6 +707,10 @@ func testAccAwsMacie2Member_invite(t *testing.T) {
 		CheckDestroy:      testAccCheckAwsMacie2InvitationAccepterDestroy,
 		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsMacieMemberConfigInvitationMessageWithoutInvite(email),
+				ExpectError: regexp.MustCompile(`"invitation_message" can only be set when "invite" is true`),
+			},
 			{
 				Config: testAccAwsMacieMemberConfigInvite(email, false),
 				Check: resource.ComposeTestCheckFunc(
@@ -113,17 +720,30 @@ func testAccAwsMacie2Member_invite(t *testing.T) {
 					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
 					testAccCheckResourceAttrAccountID(resourceName, "master_account_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
-					testAccCheckResourceAttrRfc3339(resourceName, "invited_at"),
+					resource.TestCheckResourceAttr(resourceName, "invited_at", ""),
 					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
 					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
 				),
 			},
+			{
+				Config:                  testAccAwsMacieMemberConfigInvite(email, false),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"invitation_message", "invitation_message_hash", "last_invited_at"},
+			},
+			{
+				Config:      testAccAwsMacieMemberConfigInvitationMessageUpdateWithoutInvite(email),
+				ExpectError: regexp.MustCompile(`"invitation_message" can only be set when "invite" is true`),
+			},
 			{
 				Config: testAccAwsMacieMemberConfigInvite(email, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
 					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
 					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "invitation_message_hash", macie2MemberInvitationMessageHash("This is a message of the invitation")),
+					testAccCheckResourceAttrRfc3339(resourceName, "last_invited_at"),
 					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
 					testAccCheckResourceAttrAccountID(resourceName, "master_account_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
@@ -137,7 +757,7 @@ func testAccAwsMacie2Member_invite(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"invitation_message"},
+				ImportStateVerifyIgnore: []string{"invitation_message", "invitation_message_hash", "last_invited_at"},
 			},
 		},
 	})
@@ -192,17 +812,16 @@ func testAccAwsMacie2Member_inviteRemoved(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"invitation_message"},
+				ImportStateVerifyIgnore: []string{"invitation_message", "invitation_message_hash", "last_invited_at"},
 			},
 		},
 	})
 }
 
-func testAccAwsMacie2Member_status(t *testing.T) {
+func testAccAwsMacie2Member_inviteRemovedReinvited(t *testing.T) {
 	var macie2Output macie2.GetMemberOutput
 	var providers []*schema.Provider
 	resourceName := "aws_macie2_member.member"
-	dataSourceAlternate := "data.aws_caller_identity.member"
 	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2AlternateEmail, EnvVarMacie2AlternateEmailMessageError)
 
 	resource.Test(t, resource.TestCase{
@@ -215,50 +834,92 @@ func testAccAwsMacie2Member_status(t *testing.T) {
 		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusEnabled, true),
+				Config: testAccAwsMacieMemberConfigInvite(email, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
 					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
 					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
-					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
-					testAccCheckResourceAttrAccountID(resourceName, "master_account_id"),
-					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
-					testAccCheckResourceAttrRfc3339(resourceName, "invited_at"),
-					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
-					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
 				),
 			},
 			{
-				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusPaused, true),
+				Config: testAccAwsMacieMemberConfigInvite(email, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
-					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusPaused),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusRemoved),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberConfigInvite(email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
 					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
-					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
-					testAccCheckResourceAttrAccountID(resourceName, "master_account_id"),
-					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
 					testAccCheckResourceAttrRfc3339(resourceName, "invited_at"),
-					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_invitePaused(t *testing.T) {
+	var macie2Output macie2.GetMemberOutput
+	var providers []*schema.Provider
+	resourceName := "aws_macie2_member.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2AlternateEmail, EnvVarMacie2AlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2InvitationAccepterDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMemberConfigOnInviteFalsePause(email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "on_invite_false", "pause"),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberConfigOnInviteFalsePause(email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusPaused),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
 					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
 				),
 			},
 			{
-				Config:                  testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusPaused, true),
+				Config: testAccAwsMacieMemberConfigOnInviteFalsePause(email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config:                  testAccAwsMacieMemberConfigOnInviteFalsePause(email, true),
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"invitation_message"},
+				ImportStateVerifyIgnore: []string{"invitation_message", "invitation_message_hash", "last_invited_at", "on_invite_false"},
 			},
 		},
 	})
 }
 
-func testAccAwsMacie2Member_withTags(t *testing.T) {
-	var providers []*schema.Provider
+func testAccAwsMacie2Member_status(t *testing.T) {
 	var macie2Output macie2.GetMemberOutput
+	var providers []*schema.Provider
 	resourceName := "aws_macie2_member.member"
-	email := "required@example.com"
 	dataSourceAlternate := "data.aws_caller_identity.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2AlternateEmail, EnvVarMacie2AlternateEmailMessageError)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -266,15 +927,156 @@ func testAccAwsMacie2Member_withTags(t *testing.T) {
 			testAccAlternateAccountPreCheck(t)
 		},
 		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
-		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		CheckDestroy:      testAccCheckAwsMacie2InvitationAccepterDestroy,
 		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAwsMacieMemberConfigWithTags(email),
+				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusEnabled, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "master_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
 					testAccCheckResourceAttrRfc3339(resourceName, "invited_at"),
 					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusPaused, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusPaused),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "master_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
+					testAccCheckResourceAttrRfc3339(resourceName, "invited_at"),
+					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+			{
+				Config:                  testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusPaused, true),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"invitation_message", "invitation_message_hash", "last_invited_at"},
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_outOfBandChanges(t *testing.T) {
+	var macie2Output macie2.GetMemberOutput
+	var providers []*schema.Provider
+	resourceName := "aws_macie2_member.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2AlternateEmail, EnvVarMacie2AlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2InvitationAccepterDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+				),
+			},
+			{
+				// The member is paused out-of-band, which must show a difference on status.
+				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberUpdateSession(resourceName, macie2.MacieStatusPaused),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				// The member is removed out-of-band, which must show a difference on invite.
+				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberDisassociate(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAwsMacieMemberConfigStatus(email, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_createPaused(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.member"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccOrganizationManagementAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMemberConfigOrganizationStatus(email, macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "organization_managed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusPaused),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_withTags(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.member"
+	email := "required@example.com"
+	dataSourceAlternate := "data.aws_caller_identity.member"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMemberConfigWithTags(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invited_at", ""),
+					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Key", "value"),
 					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
@@ -293,6 +1095,179 @@ func testAccAwsMacie2Member_withTags(t *testing.T) {
 	})
 }
 
+func testAccAwsMacie2Member_inheritAccountTags(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.member"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccOrganizationManagementAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMemberConfigInheritAccountTags(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "inherit_account_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key", "value"),
+					resource.TestCheckResourceAttrSet(resourceName, "inherited_tags.%"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key", "value"),
+				),
+			},
+			{
+				// The inherited account tags must not cause a difference on the next plan.
+				Config:   testAccAwsMacieMemberConfigInheritAccountTags(email),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_defaultTags(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.member"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(
+					testAccAWSProviderConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccAwsMacieMemberConfigWithTags(email),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key", "value"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key", "value"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				Config: composeConfig(
+					testAccAWSProviderConfigDefaultTags_Tags1("providerkey1", "value1"),
+					testAccAwsMacieMemberConfigWithTags(email),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "value1"),
+				),
+			},
+			{
+				Config: composeConfig(
+					testAccAWSProviderConfigDefaultTags_Tags1("providerkey1", "value1"),
+					testAccAwsMacieMemberConfigWithTags(email),
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_lifecycle(t *testing.T) {
+	var macie2Output macie2.GetMemberOutput
+	var providers []*schema.Provider
+	resourceName := "aws_macie2_member.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2AlternateEmail, EnvVarMacie2AlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2InvitationAccepterDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMemberConfigLifecycle(email, true, macie2.MacieStatusEnabled, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckAwsMacie2MemberRelationshipStatus(&macie2Output, macie2.RelationshipStatusInvited),
+					testAccCheckAwsMacie2MemberConsistentState(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				// The invitation is accepted after the member is read, so the member state is only checked on the next step.
+				Config: testAccAwsMacieMemberConfigLifecycle(email, true, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckAwsMacie2MemberRelationshipStatus(&macie2Output, macie2.RelationshipStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberConfigLifecycle(email, true, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckAwsMacie2MemberRelationshipStatus(&macie2Output, macie2.RelationshipStatusEnabled),
+					testAccCheckAwsMacie2MemberConsistentState(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberConfigLifecycle(email, true, macie2.MacieStatusPaused, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckAwsMacie2MemberRelationshipStatus(&macie2Output, macie2.RelationshipStatusPaused),
+					testAccCheckAwsMacie2MemberConsistentState(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberConfigLifecycle(email, true, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckAwsMacie2MemberRelationshipStatus(&macie2Output, macie2.RelationshipStatusEnabled),
+					testAccCheckAwsMacie2MemberConsistentState(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberConfigLifecycle(email, false, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckAwsMacie2MemberRelationshipStatus(&macie2Output, macie2.RelationshipStatusRemoved),
+					testAccCheckAwsMacie2MemberConsistentState(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+				),
+			},
+			{
+				Config: testAccAwsMacieMemberConfigLifecycle(email, true, macie2.MacieStatusEnabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckAwsMacie2MemberRelationshipStatus(&macie2Output, macie2.RelationshipStatusInvited),
+					testAccCheckAwsMacie2MemberConsistentState(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					testAccCheckResourceAttrRfc3339(resourceName, "last_invited_at"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsMacie2MemberExists(resourceName string, macie2Session *macie2.GetMemberOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -319,6 +1294,108 @@ func testAccCheckAwsMacie2MemberExists(resourceName string, macie2Session *macie
 	}
 }
 
+// testAccCheckAwsMacie2MemberUpdateSession changes the status of the member outside of Terraform.
+func testAccCheckAwsMacie2MemberUpdateSession(resourceName, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.UpdateMemberSession(&macie2.UpdateMemberSessionInput{
+			Id:     aws.String(rs.Primary.ID),
+			Status: aws.String(status),
+		})
+
+		return err
+	}
+}
+
+// testAccCheckAwsMacie2MemberDisassociate removes the member outside of Terraform.
+func testAccCheckAwsMacie2MemberDisassociate(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.DisassociateMember(&macie2.DisassociateMemberInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+// testAccCheckAwsMacie2MemberRelationshipStatus checks the relationship status reported by Amazon Macie.
+func testAccCheckAwsMacie2MemberRelationshipStatus(member *macie2.GetMemberOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(member.RelationshipStatus); got != expected {
+			return fmt.Errorf("Macie Member (%s) relationship status: got %s, expected %s", aws.StringValue(member.AccountId), got, expected)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAwsMacie2MemberConsistentState checks that the attributes derived from the relationship status
+// of the member are consistent with the relationship status reported by Amazon Macie.
+func testAccCheckAwsMacie2MemberConsistentState(resourceName string, member *macie2.GetMemberOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		relationshipStatus := aws.StringValue(member.RelationshipStatus)
+		attributes := rs.Primary.Attributes
+
+		if got := attributes["relationship_status"]; got != relationshipStatus {
+			return fmt.Errorf("%s: relationship_status: got %s, expected %s", resourceName, got, relationshipStatus)
+		}
+
+		associated := tfmacie2.MemberState(relationshipStatus) == tfmacie2.MemberStateAssociated
+
+		if got := attributes["finding_publishing_frequency"]; associated == (got == "") {
+			return fmt.Errorf("%s: finding_publishing_frequency: got %q for a member with relationship status %s", resourceName, got, relationshipStatus)
+		}
+
+		if got, expected := attributes["organization_managed"], "false"; got != expected {
+			return fmt.Errorf("%s: organization_managed: got %s, expected %s", resourceName, got, expected)
+		}
+
+		switch tfmacie2.MemberState(relationshipStatus) {
+		case tfmacie2.MemberStateAssociated:
+			// The status of an associated member is its session status.
+			if expected := macie2MemberStatus(relationshipStatus, ""); attributes["status"] != expected {
+				return fmt.Errorf("%s: status: got %s, expected %s", resourceName, attributes["status"], expected)
+			}
+
+			if attributes["invite"] != "true" {
+				return fmt.Errorf("%s: invite: got %s, expected true for a member with relationship status %s", resourceName, attributes["invite"], relationshipStatus)
+			}
+		case tfmacie2.MemberStateInvited:
+			if attributes["invite"] != "true" {
+				return fmt.Errorf("%s: invite: got %s, expected true for a member with relationship status %s", resourceName, attributes["invite"], relationshipStatus)
+			}
+
+			if attributes["invited_at"] == "" {
+				return fmt.Errorf("%s: invited_at: expected a value for a member with relationship status %s", resourceName, relationshipStatus)
+			}
+		case tfmacie2.MemberStateNotAssociated:
+			if attributes["invite"] != "false" {
+				return fmt.Errorf("%s: invite: got %s, expected false for a member with relationship status %s", resourceName, attributes["invite"], relationshipStatus)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAwsMacie2MemberDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).macie2conn
 
@@ -385,6 +1462,26 @@ resource "aws_macie2_member" "member" {
 `, email)
 }
 
+func testAccAwsMacieMemberConfigInheritAccountTags(email string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_member" "member" {
+  account_id           = data.aws_caller_identity.member.account_id
+  email                = %[1]q
+  inherit_account_tags = true
+  tags = {
+    Key = "value"
+  }
+  depends_on = [aws_macie2_account.admin]
+}
+`, email)
+}
+
 func testAccAwsMacieMemberConfigInvite(email string, invite bool) string {
 	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
 data "aws_caller_identity" "member" {
@@ -403,9 +1500,75 @@ resource "aws_macie2_member" "member" {
   account_id         = data.aws_caller_identity.member.account_id
   email              = %[1]q
   invite             = %[2]t
+  invitation_message = %[2]t ? "This is a message of the invitation" : null
+  depends_on         = [aws_macie2_account.admin]
+}
+`, email, invite)
+}
+
+func testAccAwsMacieMemberConfigInvitationMessageWithoutInvite(email string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_member" "member" {
+  account_id         = "111111111111"
+  email              = %[1]q
+  invite             = false
+  invitation_message = "This is a message of the invitation"
+  depends_on         = [aws_macie2_account.admin]
+}
+`, email)
+}
+
+func testAccAwsMacieMemberConfigInvitationMessageUpdateWithoutInvite(email string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_account" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_member" "member" {
+  account_id         = data.aws_caller_identity.member.account_id
+  email              = %[1]q
+  invite             = false
   invitation_message = "This is a message of the invitation"
   depends_on         = [aws_macie2_account.admin]
 }
+`, email)
+}
+
+func testAccAwsMacieMemberConfigOnInviteFalsePause(email string, invite bool) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "admin" {}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_account" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_member" "member" {
+  account_id      = data.aws_caller_identity.member.account_id
+  email           = %[1]q
+  invite          = %[2]t
+  on_invite_false = "pause"
+  depends_on      = [aws_macie2_account.admin]
+}
+
+resource "aws_macie2_invitation_accepter" "member" {
+  provider                 = "awsalternate"
+  administrator_account_id = data.aws_caller_identity.admin.account_id
+  depends_on               = [aws_macie2_member.member]
+}
 `, email, invite)
 }
 
@@ -439,3 +1602,60 @@ resource "aws_macie2_invitation_accepter" "member" {
 }
 `, email, memberStatus, invite)
 }
+
+func testAccAwsMacieMemberConfigLifecycle(email string, invite bool, memberStatus string, accept bool) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "admin" {}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_account" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_member" "member" {
+  account_id         = data.aws_caller_identity.member.account_id
+  email              = %[1]q
+  invite             = %[2]t
+  status             = %[3]q
+  invitation_message = "This is a message of the invitation"
+  depends_on         = [aws_macie2_account.admin]
+}
+
+resource "aws_macie2_invitation_accepter" "member" {
+  count = %[4]t ? 1 : 0
+
+  provider                 = "awsalternate"
+  administrator_account_id = data.aws_caller_identity.admin.account_id
+  depends_on               = [aws_macie2_member.member]
+}
+`, email, invite, memberStatus, accept)
+}
+
+func testAccAwsMacieMemberConfigOrganizationStatus(email, memberStatus string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "admin" {}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_organization_admin_account" "admin" {
+  admin_account_id = data.aws_caller_identity.admin.account_id
+  depends_on       = [aws_macie2_account.admin]
+}
+
+resource "aws_macie2_member" "member" {
+  account_id = data.aws_caller_identity.member.account_id
+  email      = %[1]q
+  status     = %[2]q
+  depends_on = [aws_macie2_organization_admin_account.admin]
+}
+`, email, memberStatus)
+}
@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func resourceAwsMacie2MembersSession() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMacie2MembersSessionCreate,
+		ReadWithoutTimeout:   resourceMacie2MembersSessionRead,
+		UpdateWithoutTimeout: resourceMacie2MembersSessionUpdate,
+		DeleteWithoutTimeout: resourceMacie2MembersSessionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.MacieStatus_Values(), false),
+			},
+			"relationship_statuses": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceMacie2MembersSessionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := macie2MembersSessionApply(ctx, d, meta); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Macie Members Session: %w", err))
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceMacie2MembersSessionRead(ctx, d, meta)
+}
+
+func resourceMacie2MembersSessionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).macie2conn
+
+	members, err := macie2MembersSessionTargets(conn, d)
+
+	if tfmacie2.IsNotFoundError(err) {
+		log.Printf("[WARN] Macie not enabled for AWS account (%s), removing Members Session from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie Members Session (%s): %w", d.Id(), err))
+	}
+
+	relationshipStatuses := make(map[string]string, len(members))
+	status := d.Get("status").(string)
+
+	// Imported sessions have no status, it is read from the member sessions.
+	if status == "" {
+		status = macie2MembersSessionStatus(members)
+	}
+
+	for _, member := range members {
+		accountID := aws.StringValue(member.AccountId)
+		relationshipStatus := aws.StringValue(member.RelationshipStatus)
+
+		relationshipStatuses[accountID] = relationshipStatus
+
+		// Surface any member whose session no longer matches the configured status as drift.
+		switch relationshipStatus {
+		case macie2.RelationshipStatusEnabled:
+			if status == macie2.MacieStatusPaused {
+				status = macie2.MacieStatusEnabled
+			}
+		case macie2.RelationshipStatusPaused:
+			if status == macie2.MacieStatusEnabled {
+				status = macie2.MacieStatusPaused
+			}
+		}
+	}
+
+	if err := d.Set("relationship_statuses", relationshipStatuses); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie Members Session (%s): %w", "relationship_statuses", d.Id(), err))
+	}
+
+	d.Set("status", status)
+
+	return nil
+}
+
+func resourceMacie2MembersSessionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := macie2MembersSessionApply(ctx, d, meta); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Macie Members Session (%s): %w", d.Id(), err))
+	}
+
+	return resourceMacie2MembersSessionRead(ctx, d, meta)
+}
+
+func resourceMacie2MembersSessionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The member sessions are left in their current status.
+	log.Printf("[WARN] Macie Members Session (%s) removed from state, member session statuses are unchanged", d.Id())
+
+	return nil
+}
+
+// macie2MembersSessionApply updates the session of every targeted member whose status differs from the configured status.
+func macie2MembersSessionApply(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	members, err := macie2MembersSessionTargets(conn, d)
+
+	if err != nil {
+		return err
+	}
+
+	status := d.Get("status").(string)
+	var invalid []string
+
+	found := make(map[string]bool, len(members))
+	for _, member := range members {
+		found[aws.StringValue(member.AccountId)] = true
+	}
+
+	for _, v := range d.Get("account_ids").(*schema.Set).List() {
+		if accountID := v.(string); !found[accountID] {
+			invalid = append(invalid, fmt.Sprintf("%s (not associated)", accountID))
+		}
+	}
+
+	for _, member := range members {
+		accountID := aws.StringValue(member.AccountId)
+
+		switch relationshipStatus := aws.StringValue(member.RelationshipStatus); relationshipStatus {
+		case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused:
+			if strings.EqualFold(relationshipStatus, status) {
+				continue
+			}
+		default:
+			invalid = append(invalid, fmt.Sprintf("%s (%s)", accountID, relationshipStatus))
+			continue
+		}
+
+		input := &macie2.UpdateMemberSessionInput{
+			Id:     aws.String(accountID),
+			Status: aws.String(status),
+		}
+
+		log.Printf("[DEBUG] Updating Macie Member (%s) session: %s", accountID, input)
+		mutexKey := macie2MemberSessionMutexKey(accountID)
+		awsMutexKV.Lock(mutexKey)
+		_, err := conn.UpdateMemberSessionWithContext(ctx, input)
+		awsMutexKV.Unlock(mutexKey)
+
+		if err != nil {
+			return fmt.Errorf("error updating Macie Member (%s) session: %w", accountID, err)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("members must have a relationship status of %s or %s to update their session: %s", macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused, strings.Join(invalid, ", "))
+	}
+
+	return nil
+}
+
+// macie2MembersSessionStatus returns the session status of the specified members,
+// PAUSED when any member is paused, otherwise ENABLED when any member is enabled.
+func macie2MembersSessionStatus(members []*macie2.Member) string {
+	var status string
+
+	for _, member := range members {
+		switch aws.StringValue(member.RelationshipStatus) {
+		case macie2.RelationshipStatusPaused:
+			return macie2.MacieStatusPaused
+		case macie2.RelationshipStatusEnabled:
+			status = macie2.MacieStatusEnabled
+		}
+	}
+
+	return status
+}
+
+// macie2MembersSessionTargets returns the members whose session is managed.
+// When no account IDs are configured all the associated members with an active session are managed.
+func macie2MembersSessionTargets(conn *macie2.Macie2, d *schema.ResourceData) ([]*macie2.Member, error) {
+	members, err := finder.Members(conn, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs := d.Get("account_ids").(*schema.Set)
+	var result []*macie2.Member
+
+	for _, member := range members {
+		if accountIDs.Len() > 0 {
+			if accountIDs.Contains(aws.StringValue(member.AccountId)) {
+				result = append(result, member)
+			}
+
+			continue
+		}
+
+		switch aws.StringValue(member.RelationshipStatus) {
+		case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused:
+			result = append(result, member)
+		}
+	}
+
+	return result, nil
+}
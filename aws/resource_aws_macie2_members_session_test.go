@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func TestMacie2MembersSessionStatus(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		RelationshipStatuses []string
+		Expected             string
+	}{
+		{
+			Name: "no members",
+		},
+		{
+			Name:                 "enabled",
+			RelationshipStatuses: []string{macie2.RelationshipStatusEnabled, macie2.RelationshipStatusEnabled},
+			Expected:             macie2.MacieStatusEnabled,
+		},
+		{
+			Name:                 "one paused",
+			RelationshipStatuses: []string{macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused},
+			Expected:             macie2.MacieStatusPaused,
+		},
+		{
+			Name:                 "no active session",
+			RelationshipStatuses: []string{macie2.RelationshipStatusRemoved},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var members []*macie2.Member
+
+			for _, v := range testCase.RelationshipStatuses {
+				members = append(members, &macie2.Member{RelationshipStatus: aws.String(v)})
+			}
+
+			if got := macie2MembersSessionStatus(members); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccAwsMacie2MembersSession_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_macie2_members_session.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarMacie2AlternateEmail, EnvVarMacie2AlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MembersSessionDestroy,
+		ErrorCheck:        testAccErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacieMembersSessionConfig(email, macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_statuses.%", "1"),
+					testAccCheckResourceAttrAccountID(resourceName, "id"),
+				),
+			},
+			{
+				// account_ids is not populated on import, all the members with an active session are managed.
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_ids"},
+			},
+			{
+				Config: testAccAwsMacieMembersSessionConfig(email, macie2.MacieStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "relationship_statuses.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2MembersSessionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_members_session" {
+			continue
+		}
+
+		members, err := finder.Members(conn, true)
+
+		if tfmacie2.IsNotFoundError(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			accountID := aws.StringValue(member.AccountId)
+
+			if _, ok := rs.Primary.Attributes["relationship_statuses."+accountID]; !ok {
+				continue
+			}
+
+			switch aws.StringValue(member.RelationshipStatus) {
+			case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused:
+				return fmt.Errorf("macie MembersSession %q member (%s) still has an active session", rs.Primary.ID, accountID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsMacieMembersSessionConfig(email, status string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "admin" {}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_account" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_member" "member" {
+  account_id         = data.aws_caller_identity.member.account_id
+  email              = %[1]q
+  invite             = true
+  invitation_message = "This is a message of the invitation"
+  depends_on         = [aws_macie2_account.admin]
+}
+
+resource "aws_macie2_invitation_accepter" "member" {
+  provider                 = "awsalternate"
+  administrator_account_id = data.aws_caller_identity.admin.account_id
+  depends_on               = [aws_macie2_member.member]
+}
+
+resource "aws_macie2_members_session" "test" {
+  account_ids = [data.aws_caller_identity.member.account_id]
+  status      = %[2]q
+  depends_on  = [aws_macie2_invitation_accepter.member]
+}
+`, email, status)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
 )
 
 func resourceAwsMacie2OrganizationAdminAccount() *schema.Resource {
@@ -76,8 +77,7 @@ func resourceMacie2OrganizationAdminAccountRead(ctx context.Context, d *schema.R
 	res, err := getMacie2OrganizationAdminAccount(conn, d.Id())
 
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		if tfmacie2.IsNotFoundError(err) {
 			log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -105,8 +105,7 @@ func resourceMacie2OrganizationAdminAccountDelete(ctx context.Context, d *schema
 
 	_, err := conn.DisableOrganizationAdminAccountWithContext(ctx, input)
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		if tfmacie2.IsNotFoundError(err) {
 			return nil
 		}
 		return diag.FromErr(fmt.Errorf("error deleting Macie OrganizationAdminAccount (%s): %w", d.Id(), err))
@@ -13,6 +13,12 @@ func TestAccAWSMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAwsMacie2Account_WithFindingAndStatus,
 			"disappears":                   testAccAwsMacie2Account_disappears,
 		},
+		"AccountDataSource": {
+			"basic": testAccAwsMacie2AccountDataSource_basic,
+		},
+		"AdministratorDisassociation": {
+			"basic": testAccAwsMacie2AdministratorDisassociation_basic,
+		},
 		"ClassificationJob": {
 			"basic":          testAccAwsMacie2ClassificationJob_basic,
 			"name_generated": testAccAwsMacie2ClassificationJob_Name_Generated,
@@ -21,6 +27,11 @@ func TestAccAWSMacie2_serial(t *testing.T) {
 			"status":         testAccAwsMacie2ClassificationJob_Status,
 			"complete":       testAccAwsMacie2ClassificationJob_complete,
 			"tags":           testAccAwsMacie2ClassificationJob_WithTags,
+			"tags_update":    testAccAwsMacie2ClassificationJob_tags,
+			"wait":           testAccAwsMacie2ClassificationJob_waitForCompletion,
+		},
+		"ClassificationJobDataSource": {
+			"basic": testAccAwsMacie2ClassificationJobDataSource_basic,
 		},
 		"CustomDataIdentifier": {
 			"basic":              testAccAwsMacie2CustomDataIdentifier_basic,
@@ -40,18 +51,44 @@ func TestAccAWSMacie2_serial(t *testing.T) {
 			"number":         testAccAwsMacie2FindingsFilter_WithNumber,
 			"tags":           testAccAwsMacie2FindingsFilter_withTags,
 		},
+		"FindingsFilterDataSource": {
+			"basic": testAccAwsMacie2FindingsFilterDataSource_basic,
+		},
+		"FindingsFiltersDataSource": {
+			"basic": testAccAwsMacie2FindingsFiltersDataSource_basic,
+		},
 		"OrganizationAdminAccount": {
 			"basic":      testAccAwsMacie2OrganizationAdminAccount_basic,
 			"disappears": testAccAwsMacie2OrganizationAdminAccount_disappears,
 		},
+		"OrganizationAdminAccountDataSource": {
+			"basic": testAccAwsMacie2OrganizationAdminAccountDataSource_basic,
+		},
 		"Member": {
 			"basic":          testAccAwsMacie2Member_basic,
+			"create_paused":  testAccAwsMacie2Member_createPaused,
 			"disappears":     testAccAwsMacie2Member_disappears,
+			"email_case":     testAccAwsMacie2Member_emailCase,
 			"tags":           testAccAwsMacie2Member_withTags,
+			"default_tags":   testAccAwsMacie2Member_defaultTags,
+			"inherit_tags":   testAccAwsMacie2Member_inheritAccountTags,
 			"invite":         testAccAwsMacie2Member_invite,
 			"invite_removed": testAccAwsMacie2Member_inviteRemoved,
+			"invite_paused":  testAccAwsMacie2Member_invitePaused,
+			"lifecycle":      testAccAwsMacie2Member_lifecycle,
+			"out_of_band":    testAccAwsMacie2Member_outOfBandChanges,
+			"reinvite":       testAccAwsMacie2Member_inviteRemovedReinvited,
 			"status":         testAccAwsMacie2Member_status,
 		},
+		"MembersDataSource": {
+			"basic": testAccAwsMacie2MembersDataSource_basic,
+		},
+		"MemberSession": {
+			"basic": testAccAwsMacie2MemberSession_basic,
+		},
+		"MembersSession": {
+			"basic": testAccAwsMacie2MembersSession_basic,
+		},
 		"InvitationAccepter": {
 			"basic": testAccAwsMacie2InvitationAccepter_basic,
 		},
@@ -2436,6 +2436,15 @@ var validateServiceDiscoveryNamespaceName = validation.All(
 	validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z._-]+$`), ""),
 )
 
+// validateMemberAccountEmail validates the email address of an Amazon Macie or Amazon Detective member account.
+// It follows the email address pattern of the Detective API, which both services enforce:
+// plus-addressing is accepted, but internationalized domains must be given in their punycode form
+// and the domain cannot end with a dot.
+var validateMemberAccountEmail = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexp.MustCompile(`^[^\s@]+@(?:[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)+[A-Za-z]{2,63}$`), "must be a valid email address"),
+)
+
 // validateNestedExactlyOneOf is called on the map representing a nested schema element
 // Once ExactlyOneOf is supported for nested elements, this should be deprecated.
 func validateNestedExactlyOneOf(m map[string]interface{}, valid []string) error {
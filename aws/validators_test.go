@@ -3296,6 +3296,47 @@ func TestValidateServiceDiscoveryNamespaceName(t *testing.T) {
 	}
 }
 
+func TestValidateMemberAccountEmail(t *testing.T) {
+	validEmails := []string{
+		"user@example.com",
+		"user+macie@example.com",
+		"first.last@sub.example.co.uk",
+		"user@xn--bcher-kva.example",
+		"USER@EXAMPLE.COM",
+		"user@my-domain.example.com",
+	}
+	for _, v := range validEmails {
+		_, errors := validateMemberAccountEmail(v, "email")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid member account email: %q", v, errors)
+		}
+	}
+
+	invalidEmails := []string{
+		"",
+		"user",
+		"user@",
+		"@example.com",
+		"user@example",
+		"user@example.com.",
+		"user@-example.com",
+		"user@example-.com",
+		"user@bücher.example",
+		"user name@example.com",
+		"user@example.com ",
+		"user@@example.com",
+		"user@example.c",
+		// length > 64
+		strings.Repeat("x", 53) + "@example.com",
+	}
+	for _, v := range invalidEmails {
+		_, errors := validateMemberAccountEmail(v, "email")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid member account email", v)
+		}
+	}
+}
+
 func TestValidateUTCTimestamp(t *testing.T) {
 	validT := []string{
 		"2006-01-02T15:04:05Z",